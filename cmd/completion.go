@@ -0,0 +1,111 @@
+// Package cmd provides the command line interface for the application.
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tz3/goforge/internal/steps"
+)
+
+// Shells for which a completion script can be generated.
+const (
+	shellBash       = "bash"
+	shellZsh        = "zsh"
+	shellFish       = "fish"
+	shellPowerShell = "powershell"
+)
+
+// completionCmd generates the completion script for the requested shell.
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate the autocompletion script for the specified shell",
+	Long: `Generate the autocompletion script for goforge for the specified shell.
+The script completes commands, flags and the allowed flag values (frameworks, database drivers, ...)
+together with their descriptions.
+
+Bash:
+  $ source <(goforge completion bash)
+  # To load completions for each session, execute once:
+  # Linux:
+  $ goforge completion bash > /etc/bash_completion.d/goforge
+  # macOS:
+  $ goforge completion bash > $(brew --prefix)/etc/bash_completion.d/goforge
+
+Zsh:
+  # If shell completion is not already enabled in your environment, execute once:
+  $ echo "autoload -U compinit; compinit" >> ~/.zshrc
+  # To load completions for each session, execute once:
+  $ goforge completion zsh > "${fpath[1]}/_goforge"
+
+Fish:
+  $ goforge completion fish | source
+  # To load completions for each session, execute once:
+  $ goforge completion fish > ~/.config/fish/completions/goforge.fish
+
+PowerShell:
+  PS> goforge completion powershell | Out-String | Invoke-Expression
+  # To load completions for every new session, add the output of the above command to your PowerShell profile.
+`,
+	DisableFlagsInUseLine: true,
+	ValidArgs:             []string{shellBash, shellZsh, shellFish, shellPowerShell},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+		switch args[0] {
+		case shellBash:
+			return cmd.Root().GenBashCompletionV2(out, true)
+		case shellZsh:
+			return cmd.Root().GenZshCompletion(out)
+		case shellFish:
+			return cmd.Root().GenFishCompletion(out, true)
+		case shellPowerShell:
+			return cmd.Root().GenPowerShellCompletionWithDesc(out)
+		}
+		return fmt.Errorf("unsupported shell: %s", args[0])
+	},
+}
+
+// Initialize the command.
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+// stepCompletion returns a flag completion function that offers the options of the given setup step,
+// each one together with its description.
+func stepCompletion(stepKey string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		step := steps.InitSteps().Steps[stepKey]
+		completions := make([]string, 0, len(step.Options))
+		for _, option := range step.Options {
+			completions = append(completions, fmt.Sprintf("%s\t%s", option.Title, option.Desc))
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// stepSliceCompletion returns the completion function of a slice flag taking the options of the given setup step,
+// repeated or comma separated. The options follow the values already typed, and the values already chosen, in
+// the typed list or in previous occurrences of the flag, are not offered again.
+func stepSliceCompletion(stepKey, flag string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		chosen, _ := cmd.Flags().GetStringSlice(flag)
+		var typed string
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			typed = toComplete[:i+1]
+			chosen = append(chosen, strings.Split(toComplete[:i], ",")...)
+		}
+
+		step := steps.InitSteps().Steps[stepKey]
+		completions := make([]string, 0, len(step.Options))
+		for _, option := range step.Options {
+			if !slices.Contains(chosen, option.Title) {
+				completions = append(completions, fmt.Sprintf("%s%s\t%s", typed, option.Title, option.Desc))
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
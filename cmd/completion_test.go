@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletionCommand(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectError bool
+		contains    string
+	}{
+		{"bash", []string{"completion", "bash"}, false, "__start_goforge"},
+		{"zsh", []string{"completion", "zsh"}, false, "#compdef goforge"},
+		{"fish", []string{"completion", "fish"}, false, "complete -c goforge"},
+		{"powershell", []string{"completion", "powershell"}, false, "Register-ArgumentCompleter"},
+		{"unsupported shell", []string{"completion", "tcsh"}, true, ""},
+		{"missing shell", []string{"completion"}, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rootCmd.SetOut(&buf)
+			rootCmd.SetErr(&buf)
+			rootCmd.SetArgs(tt.args)
+
+			err := rootCmd.Execute()
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, buf.String(), tt.contains)
+		})
+	}
+}
+
+func TestFlagValueCompletion(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     string
+		expected []string
+	}{
+		{
+			name:     "web framework",
+			flag:     "--" + flagProjectWebFrameworkKey,
+			expected: []string{"chi\tuse go-chi from: https://github.com/go-chi/chi", "standard-library\tBuilt in standard golang library"},
+		},
+		{
+			name:     "database driver",
+			flag:     "--" + flagDatabaseDriverKey,
+			expected: []string{"postgres\t", "none\tProject with no Database setup!"},
+		},
+		{
+			name:     "data access",
+			flag:     "--" + flagDataAccessKey,
+			expected: []string{"raw\t", "gorm\t", "ent\t"},
+		},
+		{
+			name:     "sqlite backend",
+			flag:     "--" + flagSQLiteBackendKey,
+			expected: []string{"cgo\t", "pure-go\t"},
+		},
+		{
+			name:     "feature",
+			flag:     "--" + flagFeatureKey,
+			expected: []string{"migrations\t"},
+		},
+		{
+			name:     "profile",
+			flag:     "--" + flagProfileKey,
+			expected: []string{"local\t", "production\t"},
+		},
+		{
+			name:     "docker",
+			flag:     "--" + flagDockerKey,
+			expected: []string{"compose\t", "podman\t", "none\t"},
+		},
+		{
+			name:     "deploy",
+			flag:     "--" + flagDeployKey,
+			expected: []string{"none\t", "k8s\t", "helm\t"},
+		},
+		{
+			name:     "devcontainer",
+			flag:     "--" + flagDevContainerKey,
+			expected: []string{"none\t", "compose\t"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rootCmd.SetOut(&buf)
+			rootCmd.SetErr(&bytes.Buffer{})
+			rootCmd.SetArgs([]string{"__complete", "create", tt.flag, ""})
+
+			assert.NoError(t, rootCmd.Execute())
+			for _, expected := range tt.expected {
+				assert.Contains(t, buf.String(), expected)
+			}
+			assert.True(t, strings.HasSuffix(strings.TrimSpace(buf.String()), ":4"), "expected ShellCompDirectiveNoFileComp, got %q", buf.String())
+		})
+	}
+}
+
+func TestSliceFlagValueCompletion(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:        "after a comma",
+			args:        []string{"--" + flagFeatureKey, "migrations,"},
+			expected:    []string{"migrations,sqlc\t", "migrations,cache\t"},
+			notExpected: []string{"\nmigrations\t", "migrations,migrations\t"},
+		},
+		{
+			name:        "repeated flag",
+			args:        []string{"--" + flagProfileKey, "local", "--" + flagProfileKey, "test,"},
+			expected:    []string{"test,staging\t", "test,production\t"},
+			notExpected: []string{"test,local\t", "test,test\t"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rootCmd.SetOut(&buf)
+			rootCmd.SetErr(&bytes.Buffer{})
+			rootCmd.SetArgs(append([]string{"__complete", "create"}, tt.args...))
+
+			assert.NoError(t, rootCmd.Execute())
+			for _, expected := range tt.expected {
+				assert.Contains(t, buf.String(), expected)
+			}
+			for _, notExpected := range tt.notExpected {
+				assert.NotContains(t, buf.String(), notExpected)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/tz3/goforge/cmd/ui/multiinput"
+	"github.com/tz3/goforge/cmd/ui/multiselect"
 	"github.com/tz3/goforge/cmd/ui/spinner"
 	"github.com/tz3/goforge/cmd/ui/textinput"
 	"github.com/tz3/goforge/internal/project"
@@ -26,6 +27,13 @@ type Options struct {
 	ProjectName    *textinput.Output
 	ProjectType    *multiinput.Selection
 	DatabaseDriver *multiinput.Selection
+	DataAccess     *multiinput.Selection
+	SQLiteBackend  *multiinput.Selection
+	Features       *multiselect.Selection
+	Profiles       *multiselect.Selection
+	Docker         *multiinput.Selection
+	Deploy         *multiinput.Selection
+	DevContainer   *multiinput.Selection
 }
 
 // logo is the ASCII representation of the application logo.
@@ -45,6 +53,14 @@ const (
 	flagProjectTitleKey        = "title"
 	flagProjectWebFrameworkKey = "framework"
 	flagDatabaseDriverKey      = "databaseDriver"
+	flagGoVersionKey           = "go-version"
+	flagFeatureKey             = "feature"
+	flagDataAccessKey          = "data-access"
+	flagSQLiteBackendKey       = "sqlite-backend"
+	flagProfileKey             = "profile"
+	flagDockerKey              = "docker"
+	flagDeployKey              = "deploy"
+	flagDevContainerKey        = "devcontainer"
 )
 
 // Styles for rendering the logo and ending message.
@@ -60,6 +76,26 @@ func init() {
 	createCmd.Flags().StringP(flagProjectTitleKey, "t", "", "Title/name of the project to create")
 	createCmd.Flags().StringP(flagProjectWebFrameworkKey, "f", "", fmt.Sprintf("Type of web-framework to use as a router. Allowed values: %s", strings.Join(project.SupportedWebframeworks, ", ")))
 	createCmd.Flags().StringP(flagDatabaseDriverKey, "d", "", fmt.Sprintf("Database driver to use as main DB. Allowed DBs: %s", strings.Join(project.SupportedDatabaseDrivers, ", ")))
+	createCmd.Flags().String(flagGoVersionKey, "", "Go version of the project, used for the go and toolchain directives in go.mod (e.g. 1.22 or 1.22.3), the dependencies are resolved to their newest versions supporting it. Defaults to the version of the local Go toolchain")
+	createCmd.Flags().String(flagDataAccessKey, "", fmt.Sprintf("Data access style of the SQL database drivers, raw database/sql or an ORM. Allowed values: %s", strings.Join(project.SupportedDataAccess, ", ")))
+	createCmd.Flags().String(flagSQLiteBackendKey, "", fmt.Sprintf("SQLite backend of the sqlite database driver, pure-go builds without cgo. Allowed values: %s", strings.Join(project.SupportedSQLiteBackends, ", ")))
+	createCmd.Flags().StringSlice(flagFeatureKey, nil, fmt.Sprintf("Optional feature to add to the project, can be repeated or comma separated. Allowed values: %s", strings.Join(project.SupportedFeatures, ", ")))
+	createCmd.Flags().StringSlice(flagProfileKey, nil, fmt.Sprintf("Environment profile to scaffold as a .env.<profile> file with a run-<profile> Makefile target, can be repeated or comma separated. Allowed values: %s. Defaults to all of them", strings.Join(project.SupportedProfiles, ", ")))
+
+	createCmd.Flags().String(flagDockerKey, "", fmt.Sprintf("Container setup of the project, a Dockerfile with docker-compose files run by Docker or Podman, or none. Allowed values: %s. Defaults to compose", strings.Join(project.SupportedDocker, ", ")))
+	createCmd.Flags().String(flagDeployKey, "", fmt.Sprintf("Deployment target of the project, k8s generates Kubernetes manifests with kustomize overlays in deploy/k8s, helm a Helm chart in charts/<project>. Allowed values: %s. Defaults to none", strings.Join(project.SupportedDeploy, ", ")))
+	createCmd.Flags().String(flagDevContainerKey, "", fmt.Sprintf("Dev container of the project in .devcontainer, compose runs it next to the services of docker-compose.yml. Allowed values: %s. Defaults to none", strings.Join(project.SupportedDevContainer, ", ")))
+
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagProjectWebFrameworkKey, stepCompletion("web-framework")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagDatabaseDriverKey, stepCompletion("db-driver")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagDataAccessKey, stepCompletion("data-access")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagSQLiteBackendKey, stepCompletion("sqlite-backend")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagFeatureKey, stepSliceCompletion("features", flagFeatureKey)))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagProfileKey, stepSliceCompletion("profiles", flagProfileKey)))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagDockerKey, stepCompletion("docker")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagDeployKey, stepCompletion("deploy")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagDevContainerKey, stepCompletion("devcontainer")))
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc(flagProjectTitleKey, cobra.NoFileCompletions))
 }
 
 // createCmd is the command to create a new Go project.
@@ -74,6 +110,13 @@ var createCmd = &cobra.Command{
 			ProjectName:    &textinput.Output{},
 			ProjectType:    &multiinput.Selection{},
 			DatabaseDriver: &multiinput.Selection{},
+			DataAccess:     &multiinput.Selection{},
+			SQLiteBackend:  &multiinput.Selection{},
+			Features:       &multiselect.Selection{},
+			Profiles:       &multiselect.Selection{},
+			Docker:         &multiinput.Selection{},
+			Deploy:         &multiinput.Selection{},
+			DevContainer:   &multiinput.Selection{},
 		}
 
 		isInteractive := !hasChangedFlag(cmd.Flags())
@@ -82,18 +125,41 @@ var createCmd = &cobra.Command{
 		flagTitleValue := cmd.Flag(flagProjectTitleKey).Value.String()
 		flagFrameworkValue := cmd.Flag(flagProjectWebFrameworkKey).Value.String()
 		flagDatabaseDriverValue := cmd.Flag(flagDatabaseDriverKey).Value.String()
+		flagGoVersionValue := cmd.Flag(flagGoVersionKey).Value.String()
+		flagDataAccessValue := cmd.Flag(flagDataAccessKey).Value.String()
+		flagSQLiteBackendValue := cmd.Flag(flagSQLiteBackendKey).Value.String()
+		flagDockerValue := cmd.Flag(flagDockerKey).Value.String()
+		flagDeployValue := cmd.Flag(flagDeployKey).Value.String()
+		flagDevContainerValue := cmd.Flag(flagDevContainerKey).Value.String()
+		flagFeatureValues, err := cmd.Flags().GetStringSlice(flagFeatureKey)
+		cobra.CheckErr(err)
+		flagProfileValues, err := cmd.Flags().GetStringSlice(flagProfileKey)
+		cobra.CheckErr(err)
 
 		// Validate input
 		if flagTitleValue != "" {
 			validateFlags(flagTitleValue, flagFrameworkValue, flagDatabaseDriverValue)
 		}
 
+		if flagGoVersionValue == "" {
+			flagGoVersionValue = resolveLocalGoVersion(cmd)
+		}
+		validateGoVersion(flagGoVersionValue)
+
 		projectConfig := &project.ProjectConfig{
 			ProjectName:       flagTitleValue,
 			FrameworkMap:      make(map[string]project.WebFramework),
 			ProjectType:       flagFrameworkValue,
 			DatabaseDriverMap: make(map[string]project.DatabaseDriver),
 			DatabaseDriver:    flagDatabaseDriverValue,
+			DataAccess:        flagDataAccessValue,
+			SQLiteBackend:     flagSQLiteBackendValue,
+			GoVersion:         flagGoVersionValue,
+			Features:          flagFeatureValues,
+			Profiles:          flagProfileValues,
+			Docker:            flagDockerValue,
+			Deploy:            flagDeployValue,
+			DevContainer:      flagDevContainerValue,
 		}
 
 		steps := steps.InitSteps()
@@ -111,6 +177,75 @@ var createCmd = &cobra.Command{
 			handleInteractiveDatabaseDriver(options, projectConfig, cmd, steps)
 		}
 
+		if projectConfig.DataAccess == "" {
+			if isInteractive && project.IsDataAccessSupported("gorm", projectConfig.DatabaseDriver) {
+				handleInteractiveDataAccess(options, projectConfig, cmd, steps)
+			} else {
+				projectConfig.DataAccess = "raw"
+				setFlagValue(cmd, flagDataAccessKey, projectConfig.DataAccess)
+			}
+		}
+
+		validateDataAccess(projectConfig.DataAccess, projectConfig.DatabaseDriver)
+
+		if projectConfig.SQLiteBackend == "" && projectConfig.DatabaseDriver == "sqlite" {
+			if isInteractive {
+				handleInteractiveSQLiteBackend(options, projectConfig, cmd, steps)
+			} else {
+				projectConfig.SQLiteBackend = "cgo"
+				setFlagValue(cmd, flagSQLiteBackendKey, projectConfig.SQLiteBackend)
+			}
+		}
+
+		validateSQLiteBackend(projectConfig.SQLiteBackend, projectConfig.DatabaseDriver)
+
+		if isInteractive {
+			handleInteractiveFeatures(options, projectConfig, cmd, steps)
+		}
+
+		validateFeatures(projectConfig.Features, projectConfig.DatabaseDriver, projectConfig.DataAccess)
+		projectConfig.Features = project.ResolveFeatures(projectConfig.Features)
+
+		if isInteractive {
+			handleInteractiveProfiles(options, projectConfig, cmd, steps)
+		}
+
+		validateProfiles(projectConfig.Profiles)
+		projectConfig.Profiles = project.ResolveProfiles(projectConfig.Profiles)
+
+		if projectConfig.Docker == "" {
+			if isInteractive {
+				handleInteractiveDocker(options, projectConfig, cmd, steps)
+			} else {
+				projectConfig.Docker = "compose"
+				setFlagValue(cmd, flagDockerKey, projectConfig.Docker)
+			}
+		}
+
+		validateDocker(projectConfig.Docker)
+
+		if projectConfig.Deploy == "" {
+			if isInteractive && projectConfig.HasDocker() {
+				handleInteractiveDeploy(options, projectConfig, cmd, steps)
+			} else {
+				projectConfig.Deploy = "none"
+				setFlagValue(cmd, flagDeployKey, projectConfig.Deploy)
+			}
+		}
+
+		validateDeploy(projectConfig.Deploy, projectConfig.Docker)
+
+		if projectConfig.DevContainer == "" {
+			if isInteractive && projectConfig.HasDocker() {
+				handleInteractiveDevContainer(options, projectConfig, cmd, steps)
+			} else {
+				projectConfig.DevContainer = "none"
+				setFlagValue(cmd, flagDevContainerKey, projectConfig.DevContainer)
+			}
+		}
+
+		validateDevContainer(projectConfig.DevContainer, projectConfig.Docker)
+
 		setupProject(projectConfig)
 
 		fmt.Println(endingMsgStyle.Render("\nNext steps: cd into the newly created project with:"))
@@ -127,9 +262,20 @@ var createCmd = &cobra.Command{
 func nonInteractiveCommand(flagSet *pflag.FlagSet) string {
 	nonInteractiveCommand := defaultProjectTitle
 	flagSet.VisitAll(func(flag *pflag.Flag) {
-		if flag.Name != "help" {
-			nonInteractiveCommand = fmt.Sprintf("%s --%s %s", nonInteractiveCommand, flag.Name, flag.Value.String())
+		if flag.Name == "help" {
+			return
+		}
+		value := flag.Value.String()
+		if value == "" {
+			return
 		}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			if len(sliceValue.GetSlice()) == 0 {
+				return
+			}
+			value = strings.Join(sliceValue.GetSlice(), ",")
+		}
+		nonInteractiveCommand = fmt.Sprintf("%s --%s %s", nonInteractiveCommand, flag.Name, value)
 	})
 	return nonInteractiveCommand
 }
@@ -178,6 +324,96 @@ func validateFlags(title, framework, databaseDriver string) {
 	}
 }
 
+// validateDataAccess validates the data access style of the project against the database driver.
+func validateDataAccess(dataAccess, databaseDriver string) {
+	if !project.IsValidDataAccess(dataAccess) {
+		cobra.CheckErr(fmt.Errorf("invalid data access: %s. Supported data access styles are: %s", dataAccess, strings.Join(project.SupportedDataAccess, ", ")))
+	}
+	if !project.IsDataAccessSupported(dataAccess, databaseDriver) {
+		cobra.CheckErr(fmt.Errorf("data access %s is not supported with the %s database driver", dataAccess, databaseDriver))
+	}
+}
+
+// validateSQLiteBackend validates the SQLite backend of the project, which is only used by the sqlite database driver.
+func validateSQLiteBackend(sqliteBackend, databaseDriver string) {
+	if sqliteBackend == "" {
+		return
+	}
+	if !project.IsValidSQLiteBackend(sqliteBackend) {
+		cobra.CheckErr(fmt.Errorf("invalid SQLite backend: %s. Supported backends are: %s", sqliteBackend, strings.Join(project.SupportedSQLiteBackends, ", ")))
+	}
+	if databaseDriver != "sqlite" {
+		cobra.CheckErr(fmt.Errorf("the SQLite backend %s can only be used with the sqlite database driver, not %s", sqliteBackend, databaseDriver))
+	}
+}
+
+// validateDocker validates the container setup of the project.
+func validateDocker(docker string) {
+	if !project.IsValidDocker(docker) {
+		cobra.CheckErr(fmt.Errorf("invalid Docker setup: %s. Supported setups are: %s", docker, strings.Join(project.SupportedDocker, ", ")))
+	}
+}
+
+// validateDeploy validates the deployment target of the project, which deploys the image of its Dockerfile.
+func validateDeploy(deploy, docker string) {
+	if !project.IsValidDeploy(deploy) {
+		cobra.CheckErr(fmt.Errorf("invalid deployment target: %s. Supported targets are: %s", deploy, strings.Join(project.SupportedDeploy, ", ")))
+	}
+	if deploy != "none" && docker == "none" {
+		cobra.CheckErr(fmt.Errorf("the deployment target %s deploys the image of the Dockerfile, it cannot be used with --%s none", deploy, flagDockerKey))
+	}
+}
+
+// validateDevContainer validates the dev container of the project, which runs with the services of its
+// docker-compose file.
+func validateDevContainer(devContainer, docker string) {
+	if !project.IsValidDevContainer(devContainer) {
+		cobra.CheckErr(fmt.Errorf("invalid dev container: %s. Supported dev containers are: %s", devContainer, strings.Join(project.SupportedDevContainer, ", ")))
+	}
+	if devContainer != "none" && docker == "none" {
+		cobra.CheckErr(fmt.Errorf("the dev container %s runs with the services of docker-compose.yml, it cannot be used with --%s none", devContainer, flagDockerKey))
+	}
+}
+
+// validateProfiles validates the environment profiles of the project.
+func validateProfiles(profiles []string) {
+	for _, profile := range profiles {
+		if !project.IsValidProfile(profile) {
+			cobra.CheckErr(fmt.Errorf("invalid profile: %s. Supported profiles are: %s", profile, strings.Join(project.SupportedProfiles, ", ")))
+		}
+	}
+}
+
+// validateFeatures validates the optional features of the project against the database driver and data access style.
+func validateFeatures(features []string, databaseDriver, dataAccess string) {
+	for _, feature := range features {
+		if !project.IsValidFeature(feature) {
+			cobra.CheckErr(fmt.Errorf("invalid feature: %s. Supported features are: %s", feature, strings.Join(project.SupportedFeatures, ", ")))
+		}
+		if !project.IsFeatureSupported(feature, databaseDriver, dataAccess) {
+			cobra.CheckErr(fmt.Errorf("feature %s is not supported with the %s database driver and the %s data access", feature, databaseDriver, dataAccess))
+		}
+	}
+}
+
+// validateGoVersion validates the Go version of the project.
+func validateGoVersion(goVersion string) {
+	if !project.IsValidGoVersion(goVersion) {
+		cobra.CheckErr(fmt.Errorf("invalid Go version: %s. Use a Go release version such as 1.22 or 1.22.3, Go 1.21 or newer is required", goVersion))
+	}
+}
+
+// resolveLocalGoVersion returns the version of the local Go toolchain and records it as the value of the go-version flag.
+func resolveLocalGoVersion(cmd *cobra.Command) string {
+	goVersion, err := project.LocalGoVersion()
+	if err != nil {
+		log.Printf("Could not get the local Go version: %v", err)
+		cobra.CheckErr(fmt.Errorf("could not get the local Go version, set it with --%s: %v", flagGoVersionKey, err))
+	}
+	setFlagValue(cmd, flagGoVersionKey, goVersion)
+	return goVersion
+}
+
 // handleInteractiveProjectName handles interactive input for the project name.
 func handleInteractiveProjectName(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command) {
 	tprogram := tea.NewProgram(textinput.InitialTextInputModel(options.ProjectName, "What is the name of your project?", projectConfig))
@@ -222,6 +458,107 @@ func handleInteractiveDatabaseDriver(options Options, projectConfig *project.Pro
 	setFlagValue(cmd, flagDatabaseDriverKey, projectConfig.DatabaseDriver)
 }
 
+// handleInteractiveDataAccess handles interactive input for the data access style.
+func handleInteractiveDataAccess(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, steps *steps.Steps) {
+	step := steps.Steps["data-access"]
+	tprogram := tea.NewProgram(multiinput.InitialModelMulti(step.Options, options.DataAccess, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in data access input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in data access input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.DataAccess = strings.ToLower(options.DataAccess.Choice)
+	setFlagValue(cmd, flagDataAccessKey, projectConfig.DataAccess)
+}
+
+// handleInteractiveSQLiteBackend handles interactive input for the SQLite backend.
+func handleInteractiveSQLiteBackend(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, steps *steps.Steps) {
+	step := steps.Steps["sqlite-backend"]
+	tprogram := tea.NewProgram(multiinput.InitialModelMulti(step.Options, options.SQLiteBackend, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in SQLite backend input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in SQLite backend input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.SQLiteBackend = strings.ToLower(options.SQLiteBackend.Choice)
+	setFlagValue(cmd, flagSQLiteBackendKey, projectConfig.SQLiteBackend)
+}
+
+// handleInteractiveFeatures handles interactive input for the optional features, offering the ones supported by the database driver.
+func handleInteractiveFeatures(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, setupSteps *steps.Steps) {
+	step := setupSteps.Steps["features"]
+	var choices []steps.Option
+	for _, option := range step.Options {
+		if project.IsFeatureSupported(option.Title, projectConfig.DatabaseDriver, projectConfig.DataAccess) {
+			choices = append(choices, option)
+		}
+	}
+	if len(choices) == 0 {
+		return
+	}
+
+	tprogram := tea.NewProgram(multiselect.InitialModelMultiSelect(choices, options.Features, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in features input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in features input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.Features = options.Features.Choices
+	setFlagValue(cmd, flagFeatureKey, strings.Join(projectConfig.Features, ","))
+}
+
+// handleInteractiveProfiles handles interactive input for the environment profiles, selecting none scaffolds all of them.
+func handleInteractiveProfiles(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, setupSteps *steps.Steps) {
+	step := setupSteps.Steps["profiles"]
+	tprogram := tea.NewProgram(multiselect.InitialModelMultiSelect(step.Options, options.Profiles, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in profiles input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in profiles input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.Profiles = project.ResolveProfiles(options.Profiles.Choices)
+	setFlagValue(cmd, flagProfileKey, strings.Join(projectConfig.Profiles, ","))
+}
+
+// handleInteractiveDocker handles interactive input for the container setup.
+func handleInteractiveDocker(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, steps *steps.Steps) {
+	step := steps.Steps["docker"]
+	tprogram := tea.NewProgram(multiinput.InitialModelMulti(step.Options, options.Docker, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in Docker input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in Docker input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.Docker = strings.ToLower(options.Docker.Choice)
+	setFlagValue(cmd, flagDockerKey, projectConfig.Docker)
+}
+
+// handleInteractiveDeploy handles interactive input for the deployment target.
+func handleInteractiveDeploy(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, steps *steps.Steps) {
+	step := steps.Steps["deploy"]
+	tprogram := tea.NewProgram(multiinput.InitialModelMulti(step.Options, options.Deploy, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in deployment target input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in deployment target input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.Deploy = strings.ToLower(options.Deploy.Choice)
+	setFlagValue(cmd, flagDeployKey, projectConfig.Deploy)
+}
+
+// handleInteractiveDevContainer handles interactive input for the dev container.
+func handleInteractiveDevContainer(options Options, projectConfig *project.ProjectConfig, cmd *cobra.Command, steps *steps.Steps) {
+	step := steps.Steps["devcontainer"]
+	tprogram := tea.NewProgram(multiinput.InitialModelMulti(step.Options, options.DevContainer, step.Headers, projectConfig))
+	if _, err := tprogram.Run(); err != nil {
+		log.Printf("Error in dev container input: %v", err)
+		cobra.CheckErr(fmt.Errorf("error in dev container input: %v", err))
+	}
+	projectConfig.ExitCLI(tprogram)
+	projectConfig.DevContainer = strings.ToLower(options.DevContainer.Choice)
+	setFlagValue(cmd, flagDevContainerKey, projectConfig.DevContainer)
+}
+
 // setupProject sets up the project configuration and creates necessary files.
 func setupProject(projectConfig *project.ProjectConfig) {
 	if isTerminal() {
@@ -43,6 +43,28 @@ func TestNonInteractiveCommand(t *testing.T) {
 			},
 			expectedCmd: "goforge --config config.yaml --port 8080",
 		},
+		{
+			name: "Slice flag joined and empty slice flag omitted",
+			flagSetup: func() *pflag.FlagSet {
+				fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+				fs.StringSlice("feature", nil, "features")
+				_ = fs.Set("feature", "migrations,sqlc")
+				fs.StringSlice("profile", nil, "profiles")
+				return fs
+			},
+			expectedCmd: "goforge --feature migrations,sqlc",
+		},
+		{
+			name: "Empty flag omitted",
+			flagSetup: func() *pflag.FlagSet {
+				fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+				fs.String("config", "config.yaml", "config file")
+				_ = fs.Set("config", "config.yaml")
+				fs.String("sqlite-backend", "", "sqlite backend")
+				return fs
+			},
+			expectedCmd: "goforge --config config.yaml",
+		},
 		{
 			name: "Help flag ignored",
 			flagSetup: func() *pflag.FlagSet {
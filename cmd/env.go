@@ -0,0 +1,166 @@
+// Package cmd provides the command line interface for the application.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tz3/goforge/internal/envfile"
+)
+
+const (
+	flagValueKey       = "value"
+	flagPlaceholderKey = "placeholder"
+	flagDescriptionKey = "description"
+)
+
+// envCmd groups the commands managing the environment files of a project.
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage the environment files of a project created by goforge",
+	Long: fmt.Sprintf(`Manage the environment files of a project created by goforge: %s, the local settings ignored by
+git, and %s, which documents the same variables with placeholders.`, envfile.EnvFile, envfile.ExampleFile),
+}
+
+// envCheckCmd reports the differences between the environment files and the config package of the project.
+var envCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Report the variables missing from or unused by the environment files",
+	Long: fmt.Sprintf(`Compare %[1]s with %[2]s, and %[2]s with the variables read by %[3]s and the
+docker-compose files. The .env.<profile> overlays document the variables of the environment profiles.
+
+The command exits with an error when the files diverge, so that it can run in CI, where %[1]s
+is not compared as it is ignored by git.`, envfile.EnvFile, envfile.ExampleFile, envfile.ConfigFile),
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: cobra.NoFileCompletions,
+	Run: func(cmd *cobra.Command, args []string) {
+		report, err := envfile.Check(cmd.Flag(flagProjectDirKey).Value.String())
+		cobra.CheckErr(err)
+
+		printEnvReport(cmd, report)
+		if !report.InSync() {
+			cobra.CheckErr(errors.New("the environment files are out of sync, run goforge env sync to add the missing variables"))
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "The environment files are in sync")
+	},
+}
+
+// envSyncCmd adds the missing variables to the environment files of the project.
+var envSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Add the missing variables to .env and .env.example",
+	Long: fmt.Sprintf(`Add the variables missing from %[1]s and %[2]s, including the variables read by %[3]s that
+no environment file documents. %[2]s gets a placeholder for them, %[1]s gets the value of %[2]s,
+empty for its placeholders, or a random password for the secrets.
+
+The variables unused by the config package and the docker-compose files are reported, not removed.`, envfile.EnvFile, envfile.ExampleFile, envfile.ConfigFile),
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: cobra.NoFileCompletions,
+	Run: func(cmd *cobra.Command, args []string) {
+		result, err := envfile.Sync(cmd.Flag(flagProjectDirKey).Value.String())
+		cobra.CheckErr(err)
+
+		for _, added := range []struct {
+			file string
+			keys []string
+		}{
+			{envfile.EnvFile, result.AddedToEnv},
+			{envfile.ExampleFile, result.AddedToExample},
+		} {
+			if len(added.keys) > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "• Added to %s: %s\n", added.file, strings.Join(added.keys, ", "))
+			}
+		}
+		if len(result.AddedToEnv)+len(result.AddedToExample) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No variable to add")
+		}
+		printEnvReport(cmd, envfile.Report{Unused: result.Unused})
+	},
+}
+
+// envAddCmd adds a variable to the environment files of the project.
+var envAddCmd = &cobra.Command{
+	Use:   "add <KEY>",
+	Short: "Add a variable to .env and .env.example",
+	Long: fmt.Sprintf(`Add a variable to %[1]s with its value and to %[2]s with a placeholder, both preceded by its
+description. Read it in %[3]s to make it part of the configuration.`, envfile.EnvFile, envfile.ExampleFile, envfile.ConfigFile),
+	Example:           `  goforge env add PAYMENT_API_KEY --description "API key of the payment provider"`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cobra.NoFileCompletions,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := envfile.Add(cmd.Flag(flagProjectDirKey).Value.String(), args[0],
+			cmd.Flag(flagValueKey).Value.String(), cmd.Flag(flagPlaceholderKey).Value.String(), cmd.Flag(flagDescriptionKey).Value.String())
+		cobra.CheckErr(err)
+		fmt.Fprintf(cmd.OutOrStdout(), "Added %s to the environment files, read it in %s, e.g. with l.string(%q, \"\")\n",
+			args[0], envfile.ConfigFile, args[0])
+	},
+}
+
+// envRotateCmd regenerates the local secrets of the .env file of the project.
+var envRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Regenerate the local passwords of the .env file",
+	Long: fmt.Sprintf(`Regenerate the local passwords of the .env file with new random values, leaving the other
+variables untouched. The rotated variables are: %s.
+
+The databases of docker-compose set the passwords of their users when their volume is initialized,
+recreate the containers with their volumes to apply the new passwords.`, strings.Join(envfile.SecretKeys, ", ")),
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: cobra.NoFileCompletions,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := filepath.Join(cmd.Flag(flagProjectDirKey).Value.String(), envfile.EnvFile)
+		rotated, err := envfile.Rotate(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = fmt.Errorf("%s not found, run the command from the root of a project created by goforge", path)
+		}
+		cobra.CheckErr(err)
+
+		if len(rotated) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "No password to rotate in %s\n", path)
+			return
+		}
+		for _, key := range rotated {
+			fmt.Fprintf(cmd.OutOrStdout(), "• %s\n", key)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "\nRecreate the containers and their volumes to apply the new passwords:\n\n  docker compose down --volumes && docker compose up -d")
+	},
+}
+
+// printEnvReport prints the variables of each difference of the report.
+func printEnvReport(cmd *cobra.Command, report envfile.Report) {
+	for _, difference := range []struct {
+		description string
+		keys        []string
+	}{
+		{"Missing from " + envfile.EnvFile, report.MissingFromEnv},
+		{"Missing from " + envfile.ExampleFile, report.MissingFromExample},
+		{"Read by " + envfile.ConfigFile + " but not documented", report.Undocumented},
+		{"Used by neither " + envfile.ConfigFile + " nor docker-compose", report.Unused},
+	} {
+		if len(difference.keys) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "• %s: %s\n", difference.description, strings.Join(difference.keys, ", "))
+		}
+	}
+}
+
+// Initialize the commands and flags.
+func init() {
+	rootCmd.AddCommand(envCmd)
+	envCmd.AddCommand(envCheckCmd)
+	envCmd.AddCommand(envSyncCmd)
+	envCmd.AddCommand(envAddCmd)
+	envCmd.AddCommand(envRotateCmd)
+	envCmd.PersistentFlags().String(flagProjectDirKey, ".", "Root directory of the project")
+	envAddCmd.Flags().String(flagValueKey, "", "Value of the variable in "+envfile.EnvFile)
+	envAddCmd.Flags().String(flagPlaceholderKey, "", "Placeholder of the variable in "+envfile.ExampleFile+", e.g. <api-key> for API_KEY by default")
+	envAddCmd.Flags().String(flagDescriptionKey, "", "Description of the variable, written as a comment in both files")
+	cobra.CheckErr(envCmd.MarkPersistentFlagDirname(flagProjectDirKey))
+	for _, flag := range []string{flagValueKey, flagPlaceholderKey, flagDescriptionKey} {
+		cobra.CheckErr(envAddCmd.RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
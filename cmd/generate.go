@@ -0,0 +1,115 @@
+// Package cmd provides the command line interface for the application.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tz3/goforge/internal/generator"
+	"github.com/tz3/goforge/internal/project"
+)
+
+const (
+	flagProjectDirKey = "dir"
+	flagFieldsKey     = "fields"
+	flagNameKey       = "name"
+)
+
+// generateCmd groups the commands adding code to a project created by goforge.
+var generateCmd = &cobra.Command{
+	Use:     "generate",
+	Aliases: []string{"g"},
+	Short:   "Generate code in a project created by goforge",
+	Long: fmt.Sprintf(`Generate code in a project created by goforge, in the idiom of its web framework and database.
+The choices the project was created with are read from its %s file.`, project.ManifestFile),
+}
+
+// generateResourceCmd generates a CRUD resource in the project.
+var generateResourceCmd = &cobra.Command{
+	Use:   "resource <name>",
+	Short: "Generate a CRUD resource with its model, repository, handlers, routes, migration and tests",
+	Long: fmt.Sprintf(`Generate a CRUD resource: the model and its repository in internal/database, the list, get, create,
+update and delete handlers and their test in internal/server, the registration of their routes in
+RegisterRoutes and the table of the model, created by a migration, by the ORM or, in the projects
+without migrations, by a schema file in internal/database/schema.
+
+The fields are comma separated name:type pairs. Allowed types: %s`, strings.Join(generator.SupportedFieldTypes, ", ")),
+	Example:           "  goforge generate resource product --fields name:string,price:int",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cobra.NoFileCompletions,
+	Run: func(cmd *cobra.Command, args []string) {
+		projectPath := cmd.Flag(flagProjectDirKey).Value.String()
+		manifest, err := project.ReadManifest(projectPath)
+		cobra.CheckErr(err)
+
+		fields, err := generator.ParseFields(cmd.Flag(flagFieldsKey).Value.String())
+		cobra.CheckErr(err)
+		resource, err := generator.NewResource(manifest, args[0], fields)
+		cobra.CheckErr(err)
+
+		files, err := resource.Generate(projectPath)
+		for _, file := range files {
+			fmt.Fprintf(cmd.OutOrStdout(), "• %s\n", file)
+		}
+		cobra.CheckErr(err)
+
+		if resource.NeedsTable() {
+			fmt.Fprintf(cmd.OutOrStdout(), "\nThe project has no migrations, run %s against the database to create the %s table before using the endpoints.\n", resource.SchemaFile(), resource.Table())
+		}
+	},
+}
+
+// generateHandlerCmd generates a handler stub and registers its route in the project.
+var generateHandlerCmd = &cobra.Command{
+	Use:   "handler <METHOD> <path>",
+	Short: "Generate a handler stub with its test and register its route",
+	Long: fmt.Sprintf(`Generate a handler stub and its test in internal/server, and register its route in the route
+registration function of the web framework. The path uses {name} wildcards for its parameters, which
+are translated to the syntax of the framework.
+
+The web framework is read from the %s file, or detected from the imports of internal/server
+in the projects without one. Allowed methods: %s`, project.ManifestFile, strings.Join(generator.SupportedMethods, ", ")),
+	Example: `  goforge generate handler GET /orders/{id}
+  goforge generate handler POST /orders/{id}/cancel --name cancelOrder`,
+	Args: cobra.ExactArgs(2),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return generator.SupportedMethods, cobra.ShellCompDirectiveNoFileComp
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		projectPath := cmd.Flag(flagProjectDirKey).Value.String()
+		manifest, err := project.ReadManifest(projectPath)
+		if errors.Is(err, project.ErrManifestNotFound) {
+			manifest, err = generator.DetectManifest(projectPath)
+		}
+		cobra.CheckErr(err)
+
+		handler, err := generator.NewHandler(manifest, args[0], args[1], cmd.Flag(flagNameKey).Value.String())
+		cobra.CheckErr(err)
+
+		files, err := handler.Generate(projectPath)
+		for _, file := range files {
+			fmt.Fprintf(cmd.OutOrStdout(), "• %s\n", file)
+		}
+		cobra.CheckErr(err)
+	},
+}
+
+// Initialize the commands and flags.
+func init() {
+	rootCmd.AddCommand(generateCmd)
+	generateCmd.AddCommand(generateResourceCmd)
+	generateCmd.AddCommand(generateHandlerCmd)
+	generateCmd.PersistentFlags().String(flagProjectDirKey, ".", "Root directory of the project")
+	generateResourceCmd.Flags().String(flagFieldsKey, "", "Fields of the resource as comma separated name:type pairs, e.g. name:string,price:int")
+	generateHandlerCmd.Flags().String(flagNameKey, "", "Name of the handler, e.g. cancelOrder for cancelOrderHandler, defaults to the method and path")
+	cobra.CheckErr(generateResourceCmd.MarkFlagRequired(flagFieldsKey))
+	cobra.CheckErr(generateCmd.MarkPersistentFlagDirname(flagProjectDirKey))
+	cobra.CheckErr(generateResourceCmd.RegisterFlagCompletionFunc(flagFieldsKey, cobra.NoFileCompletions))
+	cobra.CheckErr(generateHandlerCmd.RegisterFlagCompletionFunc(flagNameKey, cobra.NoFileCompletions))
+}
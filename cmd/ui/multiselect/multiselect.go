@@ -0,0 +1,112 @@
+// Package multiselect provides a list prompt in which any number of options can be selected.
+package multiselect
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	program "github.com/tz3/goforge/internal/project"
+	"github.com/tz3/goforge/internal/steps"
+)
+
+var (
+	focusedStyle          = lipgloss.NewStyle().Foreground(lipgloss.Color("#00BFFF")).Bold(true)
+	titleStyle            = lipgloss.NewStyle().Background(lipgloss.Color("#00BFFF")).Foreground(lipgloss.Color("#FFFFFF")).Bold(true).Padding(0, 1, 0)
+	selectedItemStyle     = lipgloss.NewStyle().PaddingLeft(1).Foreground(lipgloss.Color("#FFD700")).Bold(true)
+	selectedItemDescStyle = lipgloss.NewStyle().PaddingLeft(1).Foreground(lipgloss.Color("#FFD700"))
+	descriptionStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB"))
+)
+
+// Selection holds the titles of the selected options, in the order in which they are listed.
+type Selection struct {
+	Choices []string
+}
+
+func (s *Selection) Update(values []string) {
+	s.Choices = values
+}
+
+type model struct {
+	cursor    int
+	choices   []steps.Option
+	selected  map[int]struct{}
+	selection *Selection
+	header    string
+	exit      *bool
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// InitialModelMultiSelect returns a prompt listing the choices, none of which is selected.
+func InitialModelMultiSelect(choices []steps.Option, selection *Selection, header string, program *program.ProjectConfig) model {
+	return model{
+		choices:   choices,
+		selected:  make(map[int]struct{}),
+		selection: selection,
+		header:    titleStyle.Render(header),
+		exit:      &program.Exit,
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			*m.exit = true
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.choices)-1 {
+				m.cursor++
+			}
+		case "enter", " ":
+			if _, ok := m.selected[m.cursor]; ok {
+				delete(m.selected, m.cursor)
+			} else {
+				m.selected[m.cursor] = struct{}{}
+			}
+		case "y":
+			choices := make([]string, 0, len(m.selected))
+			for i, choice := range m.choices {
+				if _, ok := m.selected[i]; ok {
+					choices = append(choices, choice.Title)
+				}
+			}
+			m.selection.Update(choices)
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	s := m.header + "\n\n"
+
+	for i, choice := range m.choices {
+		cursor := " "
+		if m.cursor == i {
+			choice.Title = selectedItemStyle.Render(choice.Title)
+			choice.Desc = selectedItemDescStyle.Render(choice.Desc)
+		}
+
+		checked := " "
+		if _, ok := m.selected[i]; ok {
+			checked = focusedStyle.Render("x")
+		}
+
+		title := focusedStyle.Render(choice.Title)
+		description := descriptionStyle.Render(choice.Desc)
+
+		s += fmt.Sprintf("%s [%s] %s\n%s\n\n", cursor, checked, title, description)
+	}
+
+	s += fmt.Sprintf("Press %s to toggle an option and %s to confirm, no option is required.\n", focusedStyle.Render("space"), focusedStyle.Render("y"))
+	return s
+}
@@ -0,0 +1,302 @@
+package envfile
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvFile is the local dotenv file of a project, ignored by git.
+	EnvFile = ".env"
+	// ExampleFile is the committed dotenv file documenting the variables of .env with placeholders.
+	ExampleFile = ".env.example"
+	// ConfigFile is the source of the config package, whose loader reads the variables.
+	ConfigFile = "internal/config/config.go"
+)
+
+var (
+	keyRegexp         = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+	composeKeyRegexp  = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)`)
+	placeholderRegexp = regexp.MustCompile(`^<.*>$`)
+)
+
+// Report lists the differences between the environment files of a project, its config package and its
+// docker-compose files.
+type Report struct {
+	// MissingFromEnv are the variables of .env.example that .env does not set.
+	MissingFromEnv []string
+	// MissingFromExample are the variables of .env that .env.example does not document.
+	MissingFromExample []string
+	// Undocumented are the variables read by the config package that neither .env.example nor the .env.<profile>
+	// overlays document.
+	Undocumented []string
+	// Unused are the variables of .env.example that neither the config package nor the docker-compose files use.
+	Unused []string
+}
+
+// InSync reports whether the environment files have no difference.
+func (r Report) InSync() bool {
+	return len(r.MissingFromEnv) == 0 && len(r.MissingFromExample) == 0 && len(r.Undocumented) == 0 && len(r.Unused) == 0
+}
+
+// environment is the set of files of a project defining and using its variables.
+type environment struct {
+	env         *File // nil when the project has no .env file, e.g. in CI
+	example     *File
+	overlays    []*File
+	configKeys  []string // nil when the project has no config package
+	composeKeys []string
+}
+
+// Check compares the .env and .env.example files of the project with each other and with the variables read
+// by its config package. The comparison of .env is skipped when the project has none, as in CI.
+func Check(projectPath string) (Report, error) {
+	e, err := readEnvironment(projectPath)
+	if err != nil {
+		return Report{}, err
+	}
+	return e.report(), nil
+}
+
+// SyncResult lists the variables added by Sync to each file.
+type SyncResult struct {
+	AddedToEnv     []string
+	AddedToExample []string
+	// Unused are the variables of .env.example that neither the config package nor the docker-compose files use,
+	// which Sync does not remove.
+	Unused []string
+}
+
+// Sync adds the variables missing from .env and .env.example, the undocumented variables of the config package
+// included. The new variables of .env get the value of .env.example, or a random password for the secrets, and
+// the new variables of .env.example get a placeholder.
+func Sync(projectPath string) (SyncResult, error) {
+	var result SyncResult
+	e, err := readEnvironment(projectPath)
+	if err != nil {
+		return result, err
+	}
+	report := e.report()
+	result.Unused = report.Unused
+
+	for _, key := range append(report.MissingFromExample, report.Undocumented...) {
+		if !e.example.Has(key) {
+			e.example.Append(key, Placeholder(key), "")
+			result.AddedToExample = append(result.AddedToExample, key)
+		}
+	}
+	if e.env != nil {
+		for _, key := range append(report.MissingFromEnv, report.Undocumented...) {
+			if e.env.Has(key) {
+				continue
+			}
+			value, _ := e.example.Value(key)
+			if value, err = localValue(key, value); err != nil {
+				return result, err
+			}
+			e.env.Append(key, value, "")
+			result.AddedToEnv = append(result.AddedToEnv, key)
+		}
+	}
+
+	if len(result.AddedToExample) > 0 {
+		if err := e.example.Write(); err != nil {
+			return result, err
+		}
+	}
+	if len(result.AddedToEnv) > 0 {
+		return result, e.env.Write()
+	}
+	return result, nil
+}
+
+// Add adds the variable to .env with its value and to .env.example with its placeholder, Placeholder(key) when
+// empty, both preceded by the description. It fails when both files already set the variable.
+func Add(projectPath, key, value, placeholder, description string) error {
+	if !keyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid variable name %q, use upper case letters, digits and underscores, e.g. API_KEY", key)
+	}
+	if placeholder == "" {
+		placeholder = Placeholder(key)
+	}
+
+	e, err := readEnvironment(projectPath)
+	if err != nil {
+		return err
+	}
+	if e.example.Has(key) && (e.env == nil || e.env.Has(key)) {
+		return fmt.Errorf("%s is already set in the environment files", key)
+	}
+
+	if !e.example.Has(key) {
+		e.example.Append(key, placeholder, description)
+		if err := e.example.Write(); err != nil {
+			return err
+		}
+	}
+	if e.env != nil && !e.env.Has(key) {
+		e.env.Append(key, value, description)
+		return e.env.Write()
+	}
+	return nil
+}
+
+// Placeholder returns the placeholder documenting the value of the variable in .env.example, e.g. <api-key>
+// for API_KEY.
+func Placeholder(key string) string {
+	return "<" + strings.ReplaceAll(strings.ToLower(key), "_", "-") + ">"
+}
+
+// ConfigKeys returns the variables read by the loader of the config package, in the order of the source.
+func ConfigKeys(path string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The methods of the loader read the variable of their first argument.
+	var methods []string
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && len(fn.Recv.List) == 1 {
+			if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "loader" {
+					methods = append(methods, fn.Name.Name)
+				}
+			}
+		}
+	}
+
+	var keys []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !slices.Contains(methods, selector.Sel.Name) {
+			return true
+		}
+		if literal, ok := call.Args[0].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+			if key, err := strconv.Unquote(literal.Value); err == nil && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+		return true
+	})
+	return keys, nil
+}
+
+// ComposeKeys returns the variables interpolated by the docker-compose files of the project.
+func ComposeKeys(projectPath string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(projectPath, "docker-compose*.yml"))
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range composeKeyRegexp.FindAllStringSubmatch(string(content), -1) {
+			if !slices.Contains(keys, match[1]) {
+				keys = append(keys, match[1])
+			}
+		}
+	}
+	return keys, nil
+}
+
+// readEnvironment reads the environment files of the project, its config package and its docker-compose files.
+func readEnvironment(projectPath string) (*environment, error) {
+	var e environment
+	var err error
+	if e.example, err = Read(filepath.Join(projectPath, ExampleFile)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%s not found in %s, run the command from the root of a project created by goforge", ExampleFile, projectPath)
+		}
+		return nil, err
+	}
+	if e.env, err = Read(filepath.Join(projectPath, EnvFile)); errors.Is(err, fs.ErrNotExist) {
+		e.env = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	overlays, err := filepath.Glob(filepath.Join(projectPath, EnvFile+".*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range overlays {
+		if filepath.Base(path) == ExampleFile {
+			continue
+		}
+		overlay, err := Read(path)
+		if err != nil {
+			return nil, err
+		}
+		e.overlays = append(e.overlays, overlay)
+	}
+
+	if e.configKeys, err = ConfigKeys(filepath.Join(projectPath, ConfigFile)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if e.composeKeys, err = ComposeKeys(projectPath); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// report compares the files of the environment. The variables of .env.example are only checked against the
+// config package of the projects which have one.
+func (e *environment) report() Report {
+	var r Report
+	if e.env != nil {
+		r.MissingFromEnv = missing(e.example.Keys(), e.env)
+		r.MissingFromExample = missing(e.env.Keys(), e.example)
+	}
+	if e.configKeys == nil {
+		return r
+	}
+	r.Undocumented = missing(e.configKeys, append([]*File{e.example}, e.overlays...)...)
+	for _, key := range e.example.Keys() {
+		if !slices.Contains(e.configKeys, key) && !slices.Contains(e.composeKeys, key) {
+			r.Unused = append(r.Unused, key)
+		}
+	}
+	return r
+}
+
+// missing returns the keys that none of the files set.
+func missing(keys []string, files ...*File) []string {
+	var result []string
+	for _, key := range keys {
+		if !slices.ContainsFunc(files, func(f *File) bool { return f.Has(key) }) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// localValue returns the value of a variable added to .env from its value in .env.example: a random password
+// for the secrets and an empty value for the other placeholders.
+func localValue(key, exampleValue string) (string, error) {
+	if slices.Contains(SecretKeys, key) {
+		return Password()
+	}
+	if placeholderRegexp.MatchString(exampleValue) {
+		return "", nil
+	}
+	return exampleValue, nil
+}
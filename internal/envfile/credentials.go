@@ -0,0 +1,110 @@
+// Package envfile generates the local credentials of the projects and edits their dotenv files.
+package envfile
+
+import (
+	"crypto/rand"
+	"math/big"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	lowerChars = "abcdefghijklmnopqrstuvwxyz"
+	upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars = "0123456789"
+
+	// passwordLength is the length of the generated passwords.
+	passwordLength = 24
+	// suffixLength is the length of the random suffix of the generated database names.
+	suffixLength = 6
+	// maxNameLength bounds the names derived from the project name, within the identifier limits of the databases.
+	maxNameLength = 24
+)
+
+// Credentials are the local credentials of a project, written in its .env file and used by its docker-compose
+// file through the same variables.
+type Credentials struct {
+	// Database is the name of the database, DB_DATABASE.
+	Database string
+	// Username is the user of the database, DB_USERNAME.
+	Username string
+	// Password is the password of the database user, DB_PASSWORD.
+	Password string
+	// RootPassword is the password of the administrator of the database, DB_ROOT_PASSWORD.
+	RootPassword string
+	// CachePassword is the password of the Redis server of the cache, CACHE_PASSWORD.
+	CachePassword string
+}
+
+// NewCredentials generates random credentials for the project. The username is derived from the project name,
+// the database name adds a random suffix to it.
+func NewCredentials(projectName string) (Credentials, error) {
+	var c Credentials
+	name := identifier(projectName)
+	suffix, err := randomString(lowerChars+digitChars, suffixLength)
+	if err != nil {
+		return c, err
+	}
+	c.Database = name + "_" + suffix
+	c.Username = name
+
+	for _, password := range []*string{&c.Password, &c.RootPassword, &c.CachePassword} {
+		if *password, err = Password(); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+// Password returns a random alphanumeric password with lower case letters, upper case letters and digits, which
+// satisfies the password policies of the databases and needs no escaping in URLs, DSNs or docker-compose files.
+func Password() (string, error) {
+	for {
+		password, err := randomString(lowerChars+upperChars+digitChars, passwordLength)
+		if err != nil {
+			return "", err
+		}
+		if strings.ContainsAny(password, lowerChars) && strings.ContainsAny(password, upperChars) && strings.ContainsAny(password, digitChars) {
+			return password, nil
+		}
+	}
+}
+
+// identifier turns the project name into a lower case identifier, valid as a user or database name.
+func identifier(projectName string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(filepath.Base(projectName)) {
+		switch {
+		case strings.ContainsRune(lowerChars+digitChars, r):
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteByte('_')
+		}
+	}
+
+	name := strings.Trim(b.String(), "_")
+	if len(name) > maxNameLength {
+		name = strings.TrimRight(name[:maxNameLength], "_")
+	}
+	switch {
+	case name == "":
+		return "app"
+	case strings.ContainsAny(name[:1], digitChars):
+		return "app_" + name
+	}
+	return name
+}
+
+// randomString returns a string of n characters drawn uniformly from chars.
+func randomString(chars string, n int) (string, error) {
+	b := make([]byte, n)
+	max := big.NewInt(int64(len(chars)))
+	for i := range b {
+		index, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = chars[index.Int64()]
+	}
+	return string(b), nil
+}
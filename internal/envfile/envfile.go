@@ -0,0 +1,138 @@
+package envfile
+
+import (
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+)
+
+// SecretKeys are the variables of the local secrets, regenerated by Rotate.
+var SecretKeys = []string{"DB_PASSWORD", "DB_ROOT_PASSWORD", "CACHE_PASSWORD"}
+
+// secretWords are the words of the names of the variables holding secrets.
+var secretWords = []string{"PASSWORD", "SECRET", "TOKEN", "API_KEY"}
+
+// IsSecret reports whether the variable holds a secret, a password, token or key, e.g. DB_PASSWORD or DB_AUTH_TOKEN.
+func IsSecret(key string) bool {
+	return slices.ContainsFunc(secretWords, func(word string) bool { return strings.Contains(key, word) })
+}
+
+// File is a dotenv file. It is kept as its lines so that editing it preserves the comments and the layout.
+type File struct {
+	Path  string
+	lines []string
+	perm  fs.FileMode
+}
+
+// Read reads the dotenv file.
+func Read(path string) (*File, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &File{Path: path, lines: strings.Split(string(content), "\n"), perm: info.Mode().Perm()}, nil
+}
+
+// Keys returns the variables of the file in their order.
+func (f *File) Keys() []string {
+	var keys []string
+	for _, line := range f.lines {
+		if key, _, ok := parseLine(line); ok && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Value returns the value of the variable, ok is false when the file does not set it.
+func (f *File) Value(key string) (value string, ok bool) {
+	for _, line := range f.lines {
+		if k, v, isVariable := parseLine(line); isVariable && k == key {
+			value, ok = v, true
+		}
+	}
+	return value, ok
+}
+
+// Has reports whether the file sets the variable.
+func (f *File) Has(key string) bool {
+	_, ok := f.Value(key)
+	return ok
+}
+
+// Append adds the variable at the end of the file, preceded by its description as a comment when it is set.
+func (f *File) Append(key, value, description string) {
+	lines := f.lines
+	if n := len(lines); n > 0 && strings.TrimSpace(lines[n-1]) == "" {
+		lines = lines[:n-1]
+	}
+	if description != "" {
+		lines = append(lines, "# "+description)
+	}
+	f.lines = append(lines, key+"="+value, "")
+}
+
+// Write writes the file, keeping its permissions.
+func (f *File) Write() error {
+	return os.WriteFile(f.Path, []byte(strings.Join(f.lines, "\n")), f.perm)
+}
+
+// Rotate replaces the values of the secret variables set in the dotenv file with new random passwords, leaving
+// the other lines untouched. The variables without a value, e.g. the password of an insecure CockroachDB node,
+// are kept empty. It returns the rotated variables in the order of the file.
+func Rotate(path string) ([]string, error) {
+	f, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotated []string
+	for i, line := range f.lines {
+		key, value, ok := parseLine(line)
+		if !ok || value == "" || !slices.Contains(SecretKeys, key) {
+			continue
+		}
+
+		password, err := Password()
+		if err != nil {
+			return nil, err
+		}
+		lineEnd := ""
+		if strings.HasSuffix(line, "\r") {
+			lineEnd = "\r"
+		}
+		f.lines[i] = line[:strings.Index(line, "=")+1] + password + lineEnd
+		rotated = append(rotated, key)
+	}
+	if len(rotated) == 0 {
+		return nil, nil
+	}
+
+	return rotated, f.Write()
+}
+
+// parseLine returns the variable and the unquoted value of a KEY=value line of a dotenv file, ok is false for
+// the blank lines and the comments.
+func parseLine(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+	key, value, ok = strings.Cut(strings.TrimPrefix(line, "export "), "=")
+	if !ok {
+		return "", "", false
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	} else if comment := strings.Index(value, " #"); comment >= 0 {
+		value = strings.TrimSpace(value[:comment])
+	}
+	return strings.TrimSpace(key), value, true
+}
@@ -0,0 +1,290 @@
+package envfile
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func Test_identifier(t *testing.T) {
+	tests := []struct {
+		projectName string
+		expected    string
+	}{
+		{"my-project", "my_project"},
+		{"MyProject", "myproject"},
+		{"github.com/acme/order-service", "order_service"},
+		{"--api--", "api"},
+		{"2fa", "app_2fa"},
+		{"___", "app"},
+		{"a-very-long-project-name-for-the-database", "a_very_long_project_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.projectName, func(t *testing.T) {
+			if got := identifier(tt.projectName); got != tt.expected {
+				t.Errorf("identifier(%q) = %q; expected %q", tt.projectName, got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_NewCredentials(t *testing.T) {
+	first, err := NewCredentials("my-project")
+	if err != nil {
+		t.Fatalf("NewCredentials() error = %v", err)
+	}
+	second, err := NewCredentials("my-project")
+	if err != nil {
+		t.Fatalf("NewCredentials() error = %v", err)
+	}
+
+	if first.Username != "my_project" {
+		t.Errorf("Username = %q; expected my_project", first.Username)
+	}
+	if !regexp.MustCompile(`^my_project_[a-z0-9]{6}$`).MatchString(first.Database) {
+		t.Errorf("Database = %q; expected my_project with a random suffix", first.Database)
+	}
+	password := regexp.MustCompile(`^[A-Za-z0-9]{24}$`)
+	for _, p := range []string{first.Password, first.RootPassword, first.CachePassword} {
+		if !password.MatchString(p) || !strings.ContainsAny(p, upperChars) || !strings.ContainsAny(p, lowerChars) || !strings.ContainsAny(p, digitChars) {
+			t.Errorf("password %q is not 24 alphanumeric characters with lower case, upper case and digits", p)
+		}
+	}
+	if first.Password == first.RootPassword || first.Password == second.Password || first.Database == second.Database {
+		t.Errorf("credentials are not random: %+v and %+v", first, second)
+	}
+}
+
+func Test_IsSecret(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{"DB_PASSWORD", true},
+		{"DB_AUTH_TOKEN", true},
+		{"PAYMENT_API_KEY", true},
+		{"JWT_SECRET", true},
+		{"DB_USERNAME", false},
+		{"CACHE_HOST", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := IsSecret(tt.key); got != tt.expected {
+				t.Errorf("IsSecret(%q) = %v; expected %v", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_Rotate(t *testing.T) {
+	content := strings.Join([]string{
+		"# Local settings",
+		"DB_USERNAME=my_project",
+		"DB_PASSWORD=old-password",
+		"export DB_ROOT_PASSWORD=\"old-root-password\"",
+		"CACHE_PASSWORD=",
+		"DB_AUTH_TOKEN=token",
+		"",
+	}, "\n")
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := Rotate(path)
+	if err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	if !slices.Equal(rotated, []string{"DB_PASSWORD", "DB_ROOT_PASSWORD"}) {
+		t.Errorf("Rotate() = %q; expected DB_PASSWORD and DB_ROOT_PASSWORD", rotated)
+	}
+
+	updated, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(updated), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("the rotated file has %d lines; expected 7:\n%s", len(lines), updated)
+	}
+	for i, expected := range []string{`^# Local settings$`, `^DB_USERNAME=my_project$`, `^DB_PASSWORD=[A-Za-z0-9]{24}$`,
+		`^export DB_ROOT_PASSWORD=[A-Za-z0-9]{24}$`, `^CACHE_PASSWORD=$`, `^DB_AUTH_TOKEN=token$`, `^$`} {
+		if !regexp.MustCompile(expected).MatchString(lines[i]) {
+			t.Errorf("line %d of the rotated file = %q; expected it to match %s", i+1, lines[i], expected)
+		}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("permissions of the rotated file = %v; expected -rw-------", info.Mode().Perm())
+	}
+}
+
+// writeProject writes the environment files of a project, with a config package reading PORT, DB_PASSWORD and
+// LOG_LEVEL and a docker-compose file interpolating DB_ROOT_PASSWORD.
+func writeProject(t *testing.T, env, example string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		EnvFile:      env,
+		ExampleFile:  example,
+		".env.local": "LOG_LEVEL=debug\n",
+		ConfigFile: `package config
+
+type loader struct{}
+
+func (l *loader) string(key, fallback string) string { return fallback }
+func (l *loader) port(key string, fallback int) int  { return fallback }
+
+func Load() {
+	l := &loader{}
+	l.port("PORT", 8080)
+	l.string("DB_PASSWORD", "")
+	l.string("LOG_LEVEL", "info")
+}
+`,
+		"docker-compose.yml": "services:\n  db:\n    environment:\n      ROOT_PASSWORD: ${DB_ROOT_PASSWORD}\n",
+	}
+	for name, content := range files {
+		if content == "" {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func Test_ConfigKeys(t *testing.T) {
+	dir := writeProject(t, "", "PORT=8080\n")
+	keys, err := ConfigKeys(filepath.Join(dir, ConfigFile))
+	if err != nil {
+		t.Fatalf("ConfigKeys() error = %v", err)
+	}
+	if !slices.Equal(keys, []string{"PORT", "DB_PASSWORD", "LOG_LEVEL"}) {
+		t.Errorf("ConfigKeys() = %q; expected PORT, DB_PASSWORD and LOG_LEVEL", keys)
+	}
+}
+
+func Test_Check(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      string
+		example  string
+		expected Report
+	}{
+		{
+			name:    "in sync",
+			env:     "PORT=8080\nDB_PASSWORD=secret\nDB_ROOT_PASSWORD=secret\n",
+			example: "PORT=8080\nDB_PASSWORD=<password>\nDB_ROOT_PASSWORD=<root-password>\n",
+		},
+		{
+			name:    "without .env",
+			example: "PORT=8080\nDB_PASSWORD=<password>\n",
+		},
+		{
+			name:    "diverging",
+			env:     "PORT=8080\nDB_PASSWORD=secret\nAPI_KEY=key\n",
+			example: "# The port\nPORT=8080\nSTALE=1\n",
+			expected: Report{
+				MissingFromEnv:     []string{"STALE"},
+				MissingFromExample: []string{"DB_PASSWORD", "API_KEY"},
+				Undocumented:       []string{"DB_PASSWORD"},
+				Unused:             []string{"STALE"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Check(writeProject(t, tt.env, tt.example))
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if !slices.Equal(report.MissingFromEnv, tt.expected.MissingFromEnv) || !slices.Equal(report.MissingFromExample, tt.expected.MissingFromExample) ||
+				!slices.Equal(report.Undocumented, tt.expected.Undocumented) || !slices.Equal(report.Unused, tt.expected.Unused) {
+				t.Errorf("Check() = %+v; expected %+v", report, tt.expected)
+			}
+			if report.InSync() != tt.expected.InSync() {
+				t.Errorf("InSync() = %v for %+v", report.InSync(), report)
+			}
+		})
+	}
+}
+
+func Test_Sync(t *testing.T) {
+	dir := writeProject(t, "PORT=8080\nAPI_KEY=key\n", "PORT=8080\nDB_PASSWORD=<password>\nDB_NAME=<database>\nDB_ROOT_PASSWORD=<root-password>\n")
+	result, err := Sync(dir)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if !slices.Equal(result.AddedToEnv, []string{"DB_PASSWORD", "DB_NAME", "DB_ROOT_PASSWORD"}) || !slices.Equal(result.AddedToExample, []string{"API_KEY"}) {
+		t.Errorf("Sync() = %+v; expected the variables of each file added to the other", result)
+	}
+
+	report, err := Check(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The variables read by neither the config package nor docker-compose are reported, not removed.
+	if !slices.Equal(report.Unused, []string{"DB_NAME", "API_KEY"}) || len(report.MissingFromEnv)+len(report.MissingFromExample)+len(report.Undocumented) > 0 {
+		t.Errorf("Check() after Sync() = %+v; expected only DB_NAME and API_KEY to be unused", report)
+	}
+
+	env, err := Read(filepath.Join(dir, EnvFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := env.Value("DB_PASSWORD"); !regexp.MustCompile(`^[A-Za-z0-9]{24}$`).MatchString(value) {
+		t.Errorf("DB_PASSWORD = %q; expected a random password", value)
+	}
+	if value, ok := env.Value("DB_NAME"); !ok || value != "" {
+		t.Errorf("DB_NAME = %q, %v; expected an empty value instead of the placeholder", value, ok)
+	}
+	example, err := Read(filepath.Join(dir, ExampleFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := example.Value("API_KEY"); value != "<api-key>" {
+		t.Errorf("API_KEY of %s = %q; expected <api-key>", ExampleFile, value)
+	}
+}
+
+func Test_Add(t *testing.T) {
+	dir := writeProject(t, "PORT=8080\n", "PORT=8080\n")
+	if err := Add(dir, "PAYMENT_API_KEY", "key", "", "API key of the payment provider"); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	for name, expected := range map[string]string{
+		EnvFile:     "PORT=8080\n# API key of the payment provider\nPAYMENT_API_KEY=key\n",
+		ExampleFile: "PORT=8080\n# API key of the payment provider\nPAYMENT_API_KEY=<payment-api-key>\n",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != expected {
+			t.Errorf("%s = %q; expected %q", name, content, expected)
+		}
+	}
+
+	if err := Add(dir, "PAYMENT_API_KEY", "", "", ""); err == nil {
+		t.Error("Add() of an existing variable succeeded; expected an error")
+	}
+	if err := Add(dir, "payment-key", "", "", ""); err == nil {
+		t.Error("Add() of an invalid name succeeded; expected an error")
+	}
+}
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tz3/goforge/internal/project"
+)
+
+// DetectManifest returns the manifest of a project created before goforge recorded it, or whose manifest was removed.
+// The module and the Go version are read from go.mod and the web framework from the imports of the server package.
+// The database driver, the data access and the features are not detected.
+func DetectManifest(projectPath string) (project.Manifest, error) {
+	var manifest project.Manifest
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return manifest, fmt.Errorf("could not read the go.mod file of the project: %v", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "module":
+			manifest.Module = strings.Trim(fields[1], `"`)
+		case "go":
+			manifest.GoVersion = fields[1]
+		}
+	}
+	if manifest.Module == "" {
+		return manifest, fmt.Errorf("no module directive in the go.mod file of the project")
+	}
+
+	manifest.Framework, err = detectFramework(filepath.Join(projectPath, serverPath))
+	return manifest, err
+}
+
+// detectFramework returns the web framework imported by the server package, or the standard library
+// when the package registers its routes without any of the frameworks.
+func detectFramework(serverDir string) (string, error) {
+	paths, err := packageFiles(serverDir)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range paths {
+		file, err := parseGoFile(path)
+		if err != nil {
+			return "", err
+		}
+		for _, spec := range file.file.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+			for framework, frameworkImport := range frameworkImports {
+				if importPath == frameworkImport {
+					return framework, nil
+				}
+			}
+		}
+	}
+	if _, _, err := findFunc(serverDir, registrationFunc("standard-library"), true); err != nil {
+		return "", fmt.Errorf("could not detect the web framework of the project: %v", err)
+	}
+	return "standard-library", nil
+}
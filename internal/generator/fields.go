@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SupportedFieldTypes are the types of the resource fields, int is a 64-bit integer and float a 64-bit float.
+var SupportedFieldTypes = []string{"string", "int", "float", "bool", "time"}
+
+// fieldTypeAliases maps the accepted spellings of the field types to the supported ones.
+var fieldTypeAliases = map[string]string{
+	"text":     "string",
+	"int64":    "int",
+	"integer":  "int",
+	"float64":  "float",
+	"boolean":  "bool",
+	"datetime": "time",
+	"date":     "time",
+}
+
+// goTypes are the Go types of the field types.
+var goTypes = map[string]string{
+	"string": "string",
+	"int":    "int64",
+	"float":  "float64",
+	"bool":   "bool",
+	"time":   "time.Time",
+}
+
+// sqlTypes are the column types of the field types for each SQL dialect.
+var sqlTypes = map[string]map[string]string{
+	"mysql": {
+		"string": "VARCHAR(255)",
+		"int":    "BIGINT",
+		"float":  "DOUBLE",
+		"bool":   "BOOLEAN",
+		"time":   "DATETIME",
+	},
+	"postgres": {
+		"string": "TEXT",
+		"int":    "BIGINT",
+		"float":  "DOUBLE PRECISION",
+		"bool":   "BOOLEAN",
+		"time":   "TIMESTAMPTZ",
+	},
+	"sqlite": {
+		"string": "TEXT",
+		"int":    "INTEGER",
+		"float":  "REAL",
+		"bool":   "BOOLEAN",
+		"time":   "TIMESTAMP",
+	},
+	"sqlserver": {
+		"string": "NVARCHAR(255)",
+		"int":    "BIGINT",
+		"float":  "FLOAT",
+		"bool":   "BIT",
+		"time":   "DATETIME2",
+	},
+}
+
+// entFieldBuilders are the ent field builders of the field types.
+var entFieldBuilders = map[string]string{
+	"string": "field.String",
+	"int":    "field.Int64",
+	"float":  "field.Float",
+	"bool":   "field.Bool",
+	"time":   "field.Time",
+}
+
+// sampleValues are the JSON values of the field types used in the generated tests.
+var sampleValues = map[string]string{
+	"string": `"sample"`,
+	"int":    `42`,
+	"float":  `4.2`,
+	"bool":   `true`,
+	"time":   `"2024-01-02T15:04:05Z"`,
+}
+
+// Field is a field of a generated resource.
+type Field struct {
+	name name
+	Type string
+}
+
+// Name returns the name of the field in snake case, used as column and JSON name.
+func (f Field) Name() string {
+	return f.name.snake()
+}
+
+// GoName returns the name of the struct field.
+func (f Field) GoName() string {
+	return f.name.pascal()
+}
+
+// GoType returns the Go type of the field.
+func (f Field) GoType() string {
+	return goTypes[f.Type]
+}
+
+// SQLType returns the column type of the field for the SQL dialect.
+func (f Field) SQLType(dialect string) string {
+	return sqlTypes[dialect][f.Type]
+}
+
+// EntField returns the ent field builder of the field, e.g. field.String("name").
+func (f Field) EntField() string {
+	return fmt.Sprintf("%s(%q)", entFieldBuilders[f.Type], f.Name())
+}
+
+// ParseFields parses a comma separated list of name:type fields, e.g. name:string,price:int.
+func ParseFields(s string) ([]Field, error) {
+	var fields []Field
+	seen := make(map[string]bool)
+	for _, definition := range strings.Split(s, ",") {
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+		fieldName, fieldType, ok := strings.Cut(definition, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q, use name:type", definition)
+		}
+		n, err := parseName(strings.TrimSpace(fieldName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %q: %v", definition, err)
+		}
+		fieldType = strings.ToLower(strings.TrimSpace(fieldType))
+		if alias, ok := fieldTypeAliases[fieldType]; ok {
+			fieldType = alias
+		}
+		if !slices.Contains(SupportedFieldTypes, fieldType) {
+			return nil, fmt.Errorf("invalid type of field %q. Supported types are: %s", definition, strings.Join(SupportedFieldTypes, ", "))
+		}
+		if n.snake() == "id" {
+			return nil, fmt.Errorf("invalid field %q, the id field is generated for every resource", definition)
+		}
+		if seen[n.snake()] {
+			return nil, fmt.Errorf("duplicate field %q", n.snake())
+		}
+		seen[n.snake()] = true
+		fields = append(fields, Field{name: n, Type: fieldType})
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one field is required, e.g. --fields name:string,price:int")
+	}
+	return fields, nil
+}
@@ -0,0 +1,57 @@
+package generator
+
+import "fmt"
+
+// frameworkImports are the import paths of the web frameworks, the standard library has none.
+var frameworkImports = map[string]string{
+	"chi":         "github.com/go-chi/chi/v5",
+	"echo":        "github.com/labstack/echo/v4",
+	"fiber":       "github.com/gofiber/fiber/v2",
+	"gin":         "github.com/gin-gonic/gin",
+	"gorilla/mux": "github.com/gorilla/mux",
+	"httprouter":  "github.com/julienschmidt/httprouter",
+}
+
+// testRouter is the variable and the constructor of the router created in the generated tests.
+type testRouter struct {
+	name        string
+	constructor string
+}
+
+// testRouters are the routers created in the generated tests, for each web framework.
+var testRouters = map[string]testRouter{
+	"standard-library": {"mux", "http.NewServeMux()"},
+	"chi":              {"r", "chi.NewRouter()"},
+	"gorilla/mux":      {"r", "mux.NewRouter()"},
+	"httprouter":       {"r", "httprouter.New()"},
+	"gin":              {"r", "gin.New()"},
+	"echo":             {"e", "echo.New()"},
+	"fiber":            {"app", "fiber.New()"},
+}
+
+// pathParamExpr returns the expression reading the path parameter in a handler of the web framework,
+// whose request is r for the net/http handlers and whose context is c for the others.
+func pathParamExpr(framework, param string) string {
+	switch framework {
+	case "standard-library":
+		return fmt.Sprintf("r.PathValue(%q)", param)
+	case "chi":
+		return fmt.Sprintf("chi.URLParam(r, %q)", param)
+	case "gorilla/mux":
+		return fmt.Sprintf("mux.Vars(r)[%q]", param)
+	case "httprouter":
+		return fmt.Sprintf("httprouter.ParamsFromContext(r.Context()).ByName(%q)", param)
+	case "fiber":
+		return fmt.Sprintf("c.Params(%q)", param)
+	}
+	return fmt.Sprintf("c.Param(%q)", param)
+}
+
+// usesNetHTTPHandlers reports whether the handlers of the web framework are net/http handler functions.
+func usesNetHTTPHandlers(framework string) bool {
+	switch framework {
+	case "standard-library", "chi", "gorilla/mux", "httprouter":
+		return true
+	}
+	return false
+}
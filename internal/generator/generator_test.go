@@ -0,0 +1,360 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tz3/goforge/internal/project"
+	"github.com/tz3/goforge/internal/templates/resource"
+)
+
+func Test_parseName(t *testing.T) {
+	tests := []struct {
+		input      string
+		pascal     string
+		camel      string
+		snake      string
+		kebab      string
+		plural     string
+		expectFail bool
+	}{
+		{"product", "Product", "product", "product", "product", "products", false},
+		{"order_item", "OrderItem", "orderItem", "order_item", "order-item", "order_items", false},
+		{"OrderItem", "OrderItem", "orderItem", "order_item", "order-item", "order_items", false},
+		{"api-key", "APIKey", "apiKey", "api_key", "api-key", "api_keys", false},
+		{"userID", "UserID", "userID", "user_id", "user-id", "user_ids", false},
+		{"category", "Category", "category", "category", "category", "categories", false},
+		{"box", "Box", "box", "box", "box", "boxes", false},
+		{"", "", "", "", "", "", true},
+		{"1item", "", "", "", "", "", true},
+		{"item!", "", "", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := parseName(tt.input)
+			if (err != nil) != tt.expectFail {
+				t.Fatalf("parseName(%q) error = %v, expectFail %v", tt.input, err, tt.expectFail)
+			}
+			if tt.expectFail {
+				return
+			}
+			if n.pascal() != tt.pascal || n.camel() != tt.camel || n.snake() != tt.snake || n.kebab() != tt.kebab {
+				t.Errorf("parseName(%q) = %s %s %s %s; expected %s %s %s %s", tt.input,
+					n.pascal(), n.camel(), n.snake(), n.kebab(), tt.pascal, tt.camel, tt.snake, tt.kebab)
+			}
+			if plural := n.plural().snake(); plural != tt.plural {
+				t.Errorf("plural of %q = %s; expected %s", tt.input, plural, tt.plural)
+			}
+		})
+	}
+}
+
+func Test_ParseFields(t *testing.T) {
+	tests := []struct {
+		input      string
+		expected   []string
+		expectFail bool
+	}{
+		{"name:string,price:int", []string{"Name string", "Price int64"}, false},
+		{" name : text , in_stock:boolean, released_at:datetime ", []string{"Name string", "InStock bool", "ReleasedAt time.Time"}, false},
+		{"weight:float64", []string{"Weight float64"}, false},
+		{"", nil, true},
+		{"name", nil, true},
+		{"name:uuid", nil, true},
+		{"id:int", nil, true},
+		{"name:string,Name:string", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			fields, err := ParseFields(tt.input)
+			if (err != nil) != tt.expectFail {
+				t.Fatalf("ParseFields(%q) error = %v, expectFail %v", tt.input, err, tt.expectFail)
+			}
+			var result []string
+			for _, field := range fields {
+				result = append(result, field.GoName()+" "+field.GoType())
+			}
+			if strings.Join(result, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("ParseFields(%q) = %v; expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_routeStatement(t *testing.T) {
+	route := Route{Method: "get", Path: "/products/{id}", Handler: "products.get"}
+	tests := []struct {
+		framework string
+		router    string
+		expected  string
+	}{
+		{"standard-library", "mux", `mux.HandleFunc("GET /products/{id}", products.get)`},
+		{"chi", "r", `r.Get("/products/{id}", products.get)`},
+		{"gorilla/mux", "r", `r.HandleFunc("/products/{id}", products.get).Methods(http.MethodGet)`},
+		{"httprouter", "r", `r.HandlerFunc(http.MethodGet, "/products/:id", products.get)`},
+		{"gin", "r", `r.GET("/products/:id", products.get)`},
+		{"echo", "e", `e.GET("/products/:id", products.get)`},
+		{"fiber", "s.App", `s.App.Get("/products/:id", products.get)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			result, err := routeStatement(tt.framework, tt.router, route)
+			if err != nil {
+				t.Fatalf("routeStatement() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("routeStatement() = %s; expected %s", result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := routeStatement("chi", "r", Route{Method: "TRACE", Path: "/", Handler: "h"}); err == nil {
+		t.Error("routeStatement() with an unsupported method should fail")
+	}
+}
+
+func Test_insertRoutes(t *testing.T) {
+	const routes = `package server
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RegisterRoutes registers the routes of the server.
+func (s *Server) RegisterRoutes() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/", s.HelloWorldHandler)
+
+	return r
+}
+
+// HelloWorldHandler says hello.
+func (s *Server) HelloWorldHandler(w http.ResponseWriter, r *http.Request) {}
+`
+	dir := t.TempDir()
+	path := filepath.Join(dir, "routes.go")
+	if err := os.WriteFile(path, []byte(routes), 0644); err != nil {
+		t.Fatalf("Error setting up test: %v", err)
+	}
+
+	file, err := parseRoutesFile(dir, "chi")
+	if err != nil {
+		t.Fatalf("parseRoutesFile() error = %v", err)
+	}
+	router, err := file.router("chi")
+	if err != nil || router != "r" {
+		t.Fatalf("router() = %q, %v; expected r", router, err)
+	}
+	if !file.hasRoute("chi", Route{Method: "GET", Path: "/"}) {
+		t.Error("hasRoute() should find the GET / route")
+	}
+	if file.hasRoute("chi", Route{Method: "POST", Path: "/"}) {
+		t.Error("hasRoute() should not find the POST / route")
+	}
+
+	statement, err := routeStatement("chi", router, Route{Method: "POST", Path: "/items", Handler: "s.createItem"})
+	if err != nil {
+		t.Fatalf("routeStatement() error = %v", err)
+	}
+	if err := file.insertStatements([]string{statement}); err != nil {
+		t.Fatalf("insertStatements() error = %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "\tr.Get(\"/\", s.HelloWorldHandler)\n\tr.Post(\"/items\", s.createItem)\n\n\treturn r\n}\n\n// HelloWorldHandler says hello.\n"
+	if !strings.Contains(string(content), expected) {
+		t.Errorf("routes.go after insertion:\n%s\nexpected to contain:\n%s", content, expected)
+	}
+}
+
+func Test_NewResource(t *testing.T) {
+	fields, err := ParseFields("name:string")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		manifest   project.Manifest
+		expectFail bool
+	}{
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "postgres", GoVersion: "1.22"}, false},
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "cockroachdb", GoVersion: "1.22"}, false},
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "libsql", GoVersion: "1.22"}, false},
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "sqlserver", GoVersion: "1.22"}, false},
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "redis", GoVersion: "1.22"}, true},
+		{"product", project.Manifest{Framework: "chi", DatabaseDriver: "none", GoVersion: "1.22"}, true},
+		{"product", project.Manifest{Framework: "standard-library", DatabaseDriver: "sqlite", GoVersion: "1.21"}, true},
+		{"type", project.Manifest{Framework: "gin", DatabaseDriver: "mysql", GoVersion: "1.22"}, true},
+		{"server", project.Manifest{Framework: "gin", DatabaseDriver: "mysql", GoVersion: "1.22"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"-"+tt.manifest.Framework+"-"+tt.manifest.DatabaseDriver, func(t *testing.T) {
+			_, err := NewResource(tt.manifest, tt.name, fields)
+			if (err != nil) != tt.expectFail {
+				t.Errorf("NewResource() error = %v, expectFail %v", err, tt.expectFail)
+			}
+		})
+	}
+}
+
+func Test_NeedsTable(t *testing.T) {
+	fields, err := ParseFields("name:string")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		manifest   project.Manifest
+		needsTable bool
+	}{
+		{project.Manifest{Framework: "chi", DatabaseDriver: "postgres", GoVersion: "1.22"}, true},
+		{project.Manifest{Framework: "chi", DatabaseDriver: "libsql", GoVersion: "1.22"}, true},
+		{project.Manifest{Framework: "chi", DatabaseDriver: "postgres", Features: []string{"migrations"}, GoVersion: "1.22"}, false},
+		{project.Manifest{Framework: "chi", DatabaseDriver: "mysql", DataAccess: "gorm", GoVersion: "1.22"}, false},
+		{project.Manifest{Framework: "chi", DatabaseDriver: "mongo", GoVersion: "1.22"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.manifest.DatabaseDriver+"-"+tt.manifest.DataAccess+"-"+strings.Join(tt.manifest.Features, ","), func(t *testing.T) {
+			r, err := NewResource(tt.manifest, "order_item", fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.NeedsTable() != tt.needsTable {
+				t.Errorf("NeedsTable() = %v; expected %v", r.NeedsTable(), tt.needsTable)
+			}
+			if schema := r.schemaSQL(); !strings.Contains(schema, "CREATE TABLE order_items (") || r.SchemaFile() != "internal/database/schema/order_items.sql" {
+				t.Errorf("schema file %s = %q; expected the creation of the order_items table", r.SchemaFile(), schema)
+			}
+		})
+	}
+}
+
+func Test_SQLServerDialect(t *testing.T) {
+	fields, err := ParseFields("name:string,active:bool,shipped_at:time")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewResource(project.Manifest{Framework: "chi", DatabaseDriver: "sqlserver", DataAccess: "raw", GoVersion: "1.22"}, "order_item", fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if placeholders := r.Placeholders(); placeholders != "@p1, @p2, @p3" {
+		t.Errorf("Placeholders() = %q; expected @p1, @p2, @p3", placeholders)
+	}
+	createTable := r.CreateTableSQL()
+	for _, column := range []string{"id BIGINT IDENTITY(1,1) PRIMARY KEY", "name NVARCHAR(255) NOT NULL", "active BIT NOT NULL", "shipped_at DATETIME2 NOT NULL"} {
+		if !strings.Contains(createTable, column) {
+			t.Errorf("CreateTableSQL() = %q; expected the column %s", createTable, column)
+		}
+	}
+
+	// The repository reads the id of the inserted row, go-mssqldb does not support LastInsertId.
+	projectPath := t.TempDir()
+	file, err := createFile(projectPath, databasePath, r.Name()+".go", resource.Repository(r.DatabaseDriver, r.DataAccess), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filepath.Join(projectPath, file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"INSERT INTO order_items (name, active, shipped_at) OUTPUT INSERTED.id VALUES (@p1, @p2, @p3)",
+		"UPDATE order_items SET name = @p1, active = @p2, shipped_at = @p3 WHERE id = @p4",
+		"DELETE FROM order_items WHERE id = @p1",
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("repository of the resource:\n%s\nexpected to contain %q", content, expected)
+		}
+	}
+	if strings.Contains(string(content), "LastInsertId") {
+		t.Errorf("repository of the resource:\n%s\nexpected not to use LastInsertId", content)
+	}
+}
+
+func Test_NewHandler(t *testing.T) {
+	manifest := project.Manifest{Framework: "chi", GoVersion: "1.22"}
+	tests := []struct {
+		method      string
+		path        string
+		handlerName string
+		expected    string
+		expectFail  bool
+	}{
+		{"GET", "/orders/{id}", "", "getOrdersHandler", false},
+		{"post", "/order-items/{id}/cancel", "", "postOrderItemsCancelHandler", false},
+		{"DELETE", "/", "", "deleteRootHandler", false},
+		{"GET", "/api/v1/users", "", "getAPIV1UsersHandler", false},
+		{"POST", "/orders/{id}/cancel", "cancelOrderHandler", "cancelOrderHandler", false},
+		{"POST", "/orders/{id}/cancel", "cancel_order", "cancelOrderHandler", false},
+		{"TRACE", "/orders", "", "", true},
+		{"GET", "orders", "", "", true},
+		{"GET", "/orders/{id}/items/{id}", "", "", true},
+		{"GET", "/orders/id-{id}", "", "", true},
+		{"GET", "/orders?all", "", "", true},
+		{"GET", "/orders.json", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			h, err := NewHandler(manifest, tt.method, tt.path, tt.handlerName)
+			if (err != nil) != tt.expectFail {
+				t.Fatalf("NewHandler() error = %v, expectFail %v", err, tt.expectFail)
+			}
+			if !tt.expectFail && h.Func() != tt.expected {
+				t.Errorf("NewHandler().Func() = %s; expected %s", h.Func(), tt.expected)
+			}
+		})
+	}
+
+	if _, err := NewHandler(project.Manifest{Framework: "standard-library", GoVersion: "1.21"}, "GET", "/", ""); err == nil {
+		t.Error("NewHandler() for the standard library before Go 1.22 should fail")
+	}
+}
+
+func Test_DetectManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		server   string
+		expected string
+	}{
+		{"echo", "package server\n\nimport \"github.com/labstack/echo/v4\"\n\nvar _ = echo.New\n", "echo"},
+		{"standard-library", "package server\n\ntype Server struct{}\n\nfunc (s *Server) RegisterRoutes() {}\n", "standard-library"},
+		{"unknown", "package server\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.MkdirAll(filepath.Join(dir, serverPath), 0751); err != nil {
+				t.Fatalf("Error setting up test: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module github.com/acme/shop\n\ngo 1.22.0\n"), 0644); err != nil {
+				t.Fatalf("Error setting up test: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, serverPath, "server.go"), []byte(tt.server), 0644); err != nil {
+				t.Fatalf("Error setting up test: %v", err)
+			}
+
+			manifest, err := DetectManifest(dir)
+			if (err != nil) != (tt.expected == "") {
+				t.Fatalf("DetectManifest() error = %v", err)
+			}
+			if err == nil && (manifest.Framework != tt.expected || manifest.Module != "github.com/acme/shop" || manifest.GoVersion != "1.22.0") {
+				t.Errorf("DetectManifest() = %+v; expected framework %s", manifest, tt.expected)
+			}
+		})
+	}
+}
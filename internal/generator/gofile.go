@@ -0,0 +1,185 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// goFile is a parsed Go file of the project. It is edited by inserting source text at the positions
+// found in its syntax tree, which keeps the comments in place unlike printing a modified tree.
+type goFile struct {
+	path string
+	src  []byte
+	fset *token.FileSet
+	file *ast.File
+}
+
+// packageFiles returns the paths of the Go files of the package directory, without the test files.
+func packageFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	files := paths[:0]
+	for _, path := range paths {
+		if !strings.HasSuffix(path, "_test.go") {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// parseGoFile parses the Go file together with its comments.
+func parseGoFile(path string) (*goFile, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	return &goFile{path: path, src: src, fset: fset, file: file}, nil
+}
+
+// findFunc finds and parses the file of the package directory declaring the function, or the method when method is set.
+func findFunc(dir, name string, method bool) (*goFile, *ast.FuncDecl, error) {
+	paths, err := packageFiles(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, path := range paths {
+		file, err := parseGoFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, decl := range file.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if ok && (fn.Recv != nil) == method && fn.Name.Name == name {
+				return file, fn, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("%s not found in %s", name, dir)
+}
+
+// declaredNames returns the names of the package level functions, types, variables and constants
+// declared in the package directory, which is empty when the directory does not exist.
+// The methods are named after their receiver type, e.g. Server.healthHandler.
+func declaredNames(dir string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	paths, err := packageFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					names[decl.Name.Name] = true
+				} else {
+					names[receiverType(decl)+"."+decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						names[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, ident := range spec.Names {
+							names[ident.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+// receiverType returns the name of the receiver type of the method, without pointer and type parameters.
+func receiverType(fn *ast.FuncDecl) string {
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch expr := expr.(type) {
+	case *ast.IndexExpr:
+		return types.ExprString(expr.X)
+	case *ast.IndexListExpr:
+		return types.ExprString(expr.X)
+	}
+	return types.ExprString(expr)
+}
+
+// insert inserts the source text at the position and writes the file back, formatted by gofmt.
+// The syntax tree is not updated, the file must be parsed again to be edited further.
+func (f *goFile) insert(pos token.Pos, text string) error {
+	offset := f.fset.Position(pos).Offset
+	src := slices.Concat(f.src[:offset], []byte(text), f.src[offset:])
+	return writeGoFile(f.path, src)
+}
+
+// lineAfter returns the position of the start of the line following the node.
+func (f *goFile) lineAfter(node ast.Node) (token.Pos, error) {
+	tokenFile := f.fset.File(node.End())
+	line := tokenFile.Line(node.End()) + 1
+	if line > tokenFile.LineCount() {
+		return token.NoPos, fmt.Errorf("unexpected end of file in %s", f.path)
+	}
+	return tokenFile.LineStart(line), nil
+}
+
+// createFile renders the template with the data into a new file of the project directory, and returns
+// its path relative to the project directory. It fails when the file already exists.
+func createFile(projectPath, dir, fileName string, tmpl []byte, data any) (string, error) {
+	path := filepath.Join(projectPath, dir, fileName)
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("%s already exists", path)
+	}
+	if err := writeTemplate(path, tmpl, data); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fileName), nil
+}
+
+// writeTemplate renders the template with the data and writes it, formatted, to the path.
+func writeTemplate(path string, tmpl []byte, data any) error {
+	parsed, err := template.New(filepath.Base(path)).Parse(string(tmpl))
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, data); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0751); err != nil {
+		return err
+	}
+	return writeGoFile(path, buf.Bytes())
+}
+
+// writeGoFile formats the Go source with gofmt and writes it to the path.
+func writeGoFile(path string, src []byte) error {
+	content, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("invalid generated code in %s: %v", path, err)
+	}
+	return os.WriteFile(path, content, 0644)
+}
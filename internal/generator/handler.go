@@ -0,0 +1,232 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tz3/goforge/internal/project"
+	"github.com/tz3/goforge/internal/templates/handler"
+	"github.com/tz3/goforge/internal/templates/resource"
+)
+
+// Handler is an HTTP handler generated in a project: a stub method of the server with its test,
+// and the registration of its route.
+type Handler struct {
+	project.Manifest
+	// Method is the HTTP method of the route, in upper case.
+	Method string
+	// Path is the path of the route, with {name} wildcards.
+	Path string
+	name name
+
+	// Receiver and ReceiverType are the receiver of the route registration function, e.g. s and *Server,
+	// which the handler is a method of. They are set by Generate.
+	Receiver     string
+	ReceiverType string
+}
+
+// NewHandler returns the handler of the route for the project of the manifest. The handler is named after the
+// method and the path, e.g. getOrderItemsHandler for GET /order-items/{id}, unless handlerName is set.
+func NewHandler(manifest project.Manifest, method, path, handlerName string) (*Handler, error) {
+	h := &Handler{Manifest: manifest, Method: strings.ToUpper(method), Path: path}
+	if !slices.Contains(SupportedMethods, h.Method) {
+		return nil, fmt.Errorf("invalid HTTP method: %s. Supported methods are: %s", method, strings.Join(SupportedMethods, ", "))
+	}
+	if err := validatePath(path); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !project.IsValidWebFramework(manifest.Framework):
+		return nil, fmt.Errorf("unsupported web framework: %s", manifest.Framework)
+	case manifest.Framework == "standard-library" && !manifest.SupportsServeMuxPatterns():
+		return nil, fmt.Errorf("handlers of the standard library router need the ServeMux patterns of Go 1.22 or newer, the project uses Go %s", manifest.GoVersion)
+	}
+
+	var err error
+	if handlerName != "" {
+		h.name, err = parseName(handlerName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid handler name %q: %v", handlerName, err)
+		}
+		if len(h.name) > 1 && h.name[len(h.name)-1] == "handler" {
+			h.name = h.name[:len(h.name)-1]
+		}
+	} else if h.name, err = routeName(h.Method, path); err != nil {
+		return nil, fmt.Errorf("could not name the handler of %s %s, set its name: %v", h.Method, path, err)
+	}
+	return h, nil
+}
+
+// validatePath checks that the route path is absolute and that its wildcards are whole {name} segments with distinct names.
+func validatePath(path string) error {
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("invalid path %q, it must start with /", path)
+	}
+	if strings.ContainsAny(path, " \t?#") {
+		return fmt.Errorf("invalid path %q, it must not contain spaces, a query or a fragment", path)
+	}
+	var params []string
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+		match := wildcardRegexp.FindStringSubmatch(segment)
+		if match == nil || match[0] != segment {
+			return fmt.Errorf("invalid path segment %q, a wildcard must be a whole {name} segment", segment)
+		}
+		if match[1] == "route" || slices.Contains(params, match[1]) {
+			return fmt.Errorf("invalid path %q, duplicate or reserved wildcard {%s}", path, match[1])
+		}
+		params = append(params, match[1])
+	}
+	return nil
+}
+
+// routeName names a handler after the method and the static segments of the path, e.g. get_order_items
+// for GET /order-items/{id}, or get_root for GET /.
+func routeName(method, path string) (name, error) {
+	n := name{strings.ToLower(method)}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || wildcardRegexp.MatchString(segment) {
+			continue
+		}
+		words, err := parseName(segment)
+		if err != nil {
+			return nil, err
+		}
+		n = append(n, words...)
+	}
+	if len(n) == 1 {
+		n = append(n, "root")
+	}
+	return n, nil
+}
+
+// Func returns the name of the handler method, e.g. getOrderItemsHandler.
+func (h *Handler) Func() string {
+	return h.name.camel() + "Handler"
+}
+
+// TestFunc returns the name of the test of the handler, e.g. TestGetOrderItemsHandler.
+func (h *Handler) TestFunc() string {
+	return "Test" + h.name.pascal() + "Handler"
+}
+
+// Route returns the method and the path of the route, e.g. GET /order-items/{id}.
+func (h *Handler) Route() string {
+	return h.Method + " " + h.Path
+}
+
+// ServerType returns the name of the server type the handler is a method of, e.g. Server.
+func (h *Handler) ServerType() string {
+	return strings.TrimPrefix(h.ReceiverType, "*")
+}
+
+// HTTPMethod returns the net/http constant of the method, e.g. http.MethodGet.
+func (h *Handler) HTTPMethod() string {
+	return "http.Method" + h.Method[:1] + strings.ToLower(h.Method[1:])
+}
+
+// Params returns the names of the path parameters of the route.
+func (h *Handler) Params() []string {
+	return pathParams(h.Path)
+}
+
+// PathParam returns the expression reading the path parameter in the handler.
+func (h *Handler) PathParam(param string) string {
+	return pathParamExpr(h.Framework, param)
+}
+
+// FrameworkImport returns the import path of the web framework, empty for the standard library.
+func (h *Handler) FrameworkImport() string {
+	return frameworkImports[h.Framework]
+}
+
+// TestRouter returns the name of the router variable of the test.
+func (h *Handler) TestRouter() string {
+	return testRouters[h.Framework].name
+}
+
+// TestRouterConstructor returns the expression creating the router of the test.
+func (h *Handler) TestRouterConstructor() string {
+	return testRouters[h.Framework].constructor
+}
+
+// TestRouteStatement returns the statement registering the handler of the server s on the router of the test.
+func (h *Handler) TestRouteStatement() (string, error) {
+	return routeStatement(h.Framework, h.TestRouter(), Route{Method: h.Method, Path: h.Path, Handler: "s." + h.Func()})
+}
+
+// TestParamValue returns the value of the path parameter in the request of the test.
+func (h *Handler) TestParamValue(param string) string {
+	return "test-" + param
+}
+
+// TestTarget returns the target of the request of the test, the path with the test values of its parameters.
+func (h *Handler) TestTarget() string {
+	return wildcardRegexp.ReplaceAllString(h.Path, "test-$1")
+}
+
+// Generate writes the handler and its test in the server package and registers its route. It returns the paths
+// of the created and updated files, relative to the project directory.
+func (h *Handler) Generate(projectPath string) ([]string, error) {
+	serverDir := filepath.Join(projectPath, serverPath)
+	routes, err := parseRoutesFile(serverDir, h.Framework)
+	if err != nil {
+		return nil, err
+	}
+	h.Receiver = routes.receiver()
+	h.ReceiverType = types.ExprString(routes.fn.Recv.List[0].Type)
+
+	serverNames, err := declaredNames(serverDir)
+	if err != nil {
+		return nil, err
+	}
+	if serverNames[h.ServerType()+"."+h.Func()] {
+		return nil, fmt.Errorf("%s is already declared in %s", h.Func(), serverPath)
+	}
+	route := Route{Method: h.Method, Path: h.Path, Handler: h.Receiver + "." + h.Func()}
+	if routes.hasRoute(h.Framework, route) {
+		return nil, fmt.Errorf("the route %s is already registered in %s", h.Route(), routes.path)
+	}
+	router, err := routes.router(h.Framework)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := routeStatement(h.Framework, router, route)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	write := func(fileName string, tmpl []byte) error {
+		file, err := createFile(projectPath, serverPath, fileName, tmpl, h)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	}
+
+	if usesNetHTTPHandlers(h.Framework) && !serverNames["WriteJSON"] {
+		if err := write("httputil.go", resource.HTTPUtil()); err != nil {
+			return files, err
+		}
+	}
+	fileName := h.name.snake() + "_handler"
+	if err := write(fileName+".go", handler.Handler(h.Framework)); err != nil {
+		return files, err
+	}
+	if err := write(fileName+"_test.go", handler.HandlerTest()); err != nil {
+		return files, err
+	}
+
+	if err := routes.insertStatements([]string{statement}); err != nil {
+		return files, err
+	}
+	return append(files, relativePath(projectPath, routes.path)), nil
+}
@@ -0,0 +1,116 @@
+// Package generator provides the code generators that add resources, handlers and routes to an existing project.
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// wordRegexp matches a lower case word of an identifier.
+var wordRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// commonInitialisms are the words written in upper case in Go identifiers, e.g. ID in UserID.
+// They are the acronyms of the ent code generator, so that the generated names match the ent ones.
+var commonInitialisms = map[string]bool{
+	"acl": true, "api": true, "ascii": true, "aws": true, "cpu": true, "css": true, "dns": true, "eof": true,
+	"gb": true, "guid": true, "hcl": true, "html": true, "http": true, "https": true, "id": true, "ip": true,
+	"json": true, "kb": true, "lhs": true, "mac": true, "mb": true, "qps": true, "ram": true, "rhs": true,
+	"rpc": true, "sla": true, "smtp": true, "sql": true, "ssh": true, "sso": true, "tcp": true, "tls": true,
+	"ttl": true, "udp": true, "ui": true, "uid": true, "uri": true, "url": true, "utf8": true, "uuid": true,
+	"vm": true, "xml": true, "xmpp": true, "xsrf": true, "xss": true,
+}
+
+// name is an identifier split into its lower case words, such as order_item, order-item or OrderItem.
+type name []string
+
+// parseName splits the identifier into its words on underscores, dashes and case changes.
+func parseName(s string) (name, error) {
+	var words name
+	var word []rune
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-':
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, unicode.ToLower(r))
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	if len(words) == 0 || !unicode.IsLetter(rune(words[0][0])) {
+		return nil, fmt.Errorf("invalid name %q, it must start with a letter", s)
+	}
+	for _, w := range words {
+		if !wordRegexp.MatchString(w) {
+			return nil, fmt.Errorf("invalid name %q, only letters, digits, underscores and dashes are allowed", s)
+		}
+	}
+	return words, nil
+}
+
+// pascal returns the exported Go identifier of the name, e.g. OrderItem.
+func (n name) pascal() string {
+	var b strings.Builder
+	for _, w := range n {
+		if commonInitialisms[w] {
+			b.WriteString(strings.ToUpper(w))
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+// camel returns the unexported Go identifier of the name, e.g. orderItem.
+func (n name) camel() string {
+	return n[0] + n[1:].pascal()
+}
+
+// snake returns the name in snake case, e.g. order_item.
+func (n name) snake() string {
+	return strings.Join(n, "_")
+}
+
+// kebab returns the name in kebab case, e.g. order-item.
+func (n name) kebab() string {
+	return strings.Join(n, "-")
+}
+
+// human returns the name as words, e.g. order item.
+func (n name) human() string {
+	return strings.Join(n, " ")
+}
+
+// plural returns the name with its last word in the plural form, e.g. order_items.
+func (n name) plural() name {
+	plural := append(name{}, n...)
+	plural[len(plural)-1] = pluralize(plural[len(plural)-1])
+	return plural
+}
+
+// pluralize returns the plural of a singular English noun using the regular rules.
+func pluralize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	}
+	return word + "s"
+}
@@ -0,0 +1,511 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tz3/goforge/internal/project"
+	"github.com/tz3/goforge/internal/templates/resource"
+)
+
+// Paths of the project.
+const (
+	databasePath   = "internal/database"
+	serverPath     = "internal/server"
+	migrationsPath = "internal/database/migrations"
+	schemaPath     = "internal/database/schema"
+	entSchemaPath  = "internal/ent/schema"
+)
+
+// migrationVersionRegexp matches the version of a migration file name, e.g. 0001 in 0001_create_users.up.sql.
+var migrationVersionRegexp = regexp.MustCompile(`^(\d+)_\w+\.(up|down)\.sql$`)
+
+// dialects are the query languages of the database drivers the resources are generated for.
+// CockroachDB speaks the PostgreSQL dialect and libSQL the SQLite one.
+var dialects = map[string]string{
+	"mysql":       "mysql",
+	"postgres":    "postgres",
+	"sqlite":      "sqlite",
+	"sqlserver":   "sqlserver",
+	"cockroachdb": "postgres",
+	"libsql":      "sqlite",
+	"mongo":       "mongo",
+}
+
+// reservedNames are the identifiers used by the generated code, which the variables named after a resource must not shadow.
+var reservedNames = []string{
+	"app", "bson", "c", "chi", "context", "ctx", "database", "e", "echo", "ent", "entities", "entity", "err", "errors",
+	"fiber", "fmt", "gin", "gorm", "h", "http", "httprouter", "httptest", "id", "io", "log", "missing", "mongo", "mux",
+	"options", "primitive", "r", "rec", "repo", "req", "resp", "result", "router", "rows", "s", "server", "sql", "strconv",
+	"strings", "t", "testing", "tests", "time", "tt", "w",
+}
+
+// Resource is a CRUD resource generated in a project: a model with its repository in the database package,
+// handlers with their test in the server package, the routes of the handlers and the table of the model.
+type Resource struct {
+	project.Manifest
+	name   name
+	Fields []Field
+}
+
+// NewResource returns the resource with the name and fields for the project of the manifest.
+func NewResource(manifest project.Manifest, resourceName string, fields []Field) (*Resource, error) {
+	n, err := parseName(resourceName)
+	if err != nil {
+		return nil, err
+	}
+	r := &Resource{Manifest: manifest, name: n, Fields: fields}
+	for _, identifier := range []string{r.Var(), r.Plural()} {
+		if token.IsKeyword(identifier) || types.Universe.Lookup(identifier) != nil || slices.Contains(reservedNames, identifier) {
+			return nil, fmt.Errorf("invalid resource name %q, %s is reserved in the generated code", resourceName, identifier)
+		}
+	}
+
+	switch {
+	case manifest.DatabaseDriver == "" || manifest.DatabaseDriver == "none":
+		return nil, fmt.Errorf("resources need a database, the project was created without a database driver")
+	case !slices.Contains(project.SupportedDatabaseDrivers, manifest.DatabaseDriver):
+		return nil, fmt.Errorf("unsupported database driver: %s", manifest.DatabaseDriver)
+	case r.Dialect() == "":
+		return nil, fmt.Errorf("resources are not supported with the %s database driver", manifest.DatabaseDriver)
+	case !project.IsValidWebFramework(manifest.Framework):
+		return nil, fmt.Errorf("unsupported web framework: %s", manifest.Framework)
+	case manifest.Framework == "standard-library" && !manifest.SupportsServeMuxPatterns():
+		return nil, fmt.Errorf("resources of the standard library router need the ServeMux patterns of Go 1.22 or newer, the project uses Go %s", manifest.GoVersion)
+	}
+	return r, nil
+}
+
+// Name returns the name of the resource in snake case, e.g. order_item.
+func (r *Resource) Name() string {
+	return r.name.snake()
+}
+
+// Type returns the name of the model of the resource, e.g. OrderItem.
+func (r *Resource) Type() string {
+	return r.name.pascal()
+}
+
+// Var returns the name of a variable holding a model of the resource, e.g. orderItem.
+func (r *Resource) Var() string {
+	return r.name.camel()
+}
+
+// Plural returns the name of a variable holding models of the resource, e.g. orderItems.
+func (r *Resource) Plural() string {
+	return r.name.plural().camel()
+}
+
+// Human returns the name of the resource as words, e.g. order item.
+func (r *Resource) Human() string {
+	return r.name.human()
+}
+
+// HumanPlural returns the plural name of the resource as words, e.g. order items.
+func (r *Resource) HumanPlural() string {
+	return r.name.plural().human()
+}
+
+// Table returns the name of the table or collection of the resource, e.g. order_items.
+func (r *Resource) Table() string {
+	return r.name.plural().snake()
+}
+
+// Path returns the path of the collection endpoints of the resource, e.g. /order-items.
+func (r *Resource) Path() string {
+	return "/" + r.name.plural().kebab()
+}
+
+// HasTime reports whether a field of the resource is a time.
+func (r *Resource) HasTime() bool {
+	return slices.ContainsFunc(r.Fields, func(f Field) bool { return f.Type == "time" })
+}
+
+// FrameworkImport returns the import path of the web framework, empty for the standard library.
+func (r *Resource) FrameworkImport() string {
+	return frameworkImports[r.Framework]
+}
+
+// PathParam returns the expression reading the path parameter in a handler of the resource.
+func (r *Resource) PathParam(param string) string {
+	return pathParamExpr(r.Framework, param)
+}
+
+// Columns returns the comma separated columns of the fields.
+func (r *Resource) Columns() string {
+	columns := make([]string, 0, len(r.Fields))
+	for _, f := range r.Fields {
+		columns = append(columns, f.Name())
+	}
+	return strings.Join(columns, ", ")
+}
+
+// Dialect returns the query language of the database driver, e.g. postgres for CockroachDB,
+// or an empty string for the drivers the resources are not generated for.
+func (r *Resource) Dialect() string {
+	return dialects[r.DatabaseDriver]
+}
+
+// Placeholder returns the query placeholder of the nth argument for the SQL database driver.
+func (r *Resource) Placeholder(n int) string {
+	switch r.Dialect() {
+	case "postgres":
+		return "$" + strconv.Itoa(n)
+	case "sqlserver":
+		return "@p" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// Placeholders returns the comma separated query placeholders of the fields.
+func (r *Resource) Placeholders() string {
+	placeholders := make([]string, 0, len(r.Fields))
+	for i := range r.Fields {
+		placeholders = append(placeholders, r.Placeholder(i+1))
+	}
+	return strings.Join(placeholders, ", ")
+}
+
+// Assignments returns the comma separated assignments of the fields in an UPDATE query.
+func (r *Resource) Assignments() string {
+	assignments := make([]string, 0, len(r.Fields))
+	for i, f := range r.Fields {
+		assignments = append(assignments, f.Name()+" = "+r.Placeholder(i+1))
+	}
+	return strings.Join(assignments, ", ")
+}
+
+// UpdateIDIndex returns the index of the id argument of the UPDATE query, after the fields.
+func (r *Resource) UpdateIDIndex() int {
+	return len(r.Fields) + 1
+}
+
+// ValueArgs returns the comma separated fields of the model variable, the arguments of the INSERT and UPDATE queries.
+func (r *Resource) ValueArgs() string {
+	args := make([]string, 0, len(r.Fields))
+	for _, f := range r.Fields {
+		args = append(args, r.Var()+"."+f.GoName())
+	}
+	return strings.Join(args, ", ")
+}
+
+// ScanArgs returns the comma separated pointers to the id and fields of the model variable, the arguments of Scan.
+func (r *Resource) ScanArgs() string {
+	args := []string{"&" + r.Var() + ".ID"}
+	for _, f := range r.Fields {
+		args = append(args, "&"+r.Var()+"."+f.GoName())
+	}
+	return strings.Join(args, ", ")
+}
+
+// QuotedGoNames returns the comma separated quoted names of the struct fields, e.g. "Name", "Price".
+func (r *Resource) QuotedGoNames() string {
+	names := make([]string, 0, len(r.Fields))
+	for _, f := range r.Fields {
+		names = append(names, strconv.Quote(f.GoName()))
+	}
+	return strings.Join(names, ", ")
+}
+
+// SampleJSON returns a JSON body of the resource used in the generated tests.
+func (r *Resource) SampleJSON() string {
+	values := make([]string, 0, len(r.Fields))
+	for _, f := range r.Fields {
+		values = append(values, fmt.Sprintf("%q:%s", f.Name(), sampleValues[f.Type]))
+	}
+	return "{" + strings.Join(values, ",") + "}"
+}
+
+// TestRouter returns the variable of the router created in the test of the handlers.
+func (r *Resource) TestRouter() string {
+	return testRouters[r.Framework].name
+}
+
+// TestRouterConstructor returns the constructor of the router created in the test of the handlers.
+func (r *Resource) TestRouterConstructor() string {
+	return testRouters[r.Framework].constructor
+}
+
+// TestRouteStatements returns the statements registering the handlers h on the router of the test.
+func (r *Resource) TestRouteStatements() ([]string, error) {
+	return r.routeStatements(r.TestRouter(), "h")
+}
+
+// routes returns the routes of the handlers of the resource.
+func (r *Resource) routes(handlers string) []Route {
+	return []Route{
+		{Method: "GET", Path: r.Path(), Handler: handlers + ".list"},
+		{Method: "POST", Path: r.Path(), Handler: handlers + ".create"},
+		{Method: "GET", Path: r.Path() + "/{id}", Handler: handlers + ".get"},
+		{Method: "PUT", Path: r.Path() + "/{id}", Handler: handlers + ".update"},
+		{Method: "DELETE", Path: r.Path() + "/{id}", Handler: handlers + ".delete"},
+	}
+}
+
+// routeStatements returns the statements registering the routes of the handlers on the router.
+func (r *Resource) routeStatements(router, handlers string) ([]string, error) {
+	var statements []string
+	for _, route := range r.routes(handlers) {
+		statement, err := routeStatement(r.Framework, router, route)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+// CreateTableSQL returns the statement creating the table of the resource for the SQL database driver.
+func (r *Resource) CreateTableSQL() string {
+	idColumns := map[string]string{
+		"mysql":     "BIGINT AUTO_INCREMENT PRIMARY KEY",
+		"postgres":  "BIGSERIAL PRIMARY KEY",
+		"sqlite":    "INTEGER PRIMARY KEY AUTOINCREMENT",
+		"sqlserver": "BIGINT IDENTITY(1,1) PRIMARY KEY",
+	}
+	columns := []string{"    id " + idColumns[r.Dialect()]}
+	for _, f := range r.Fields {
+		columns = append(columns, fmt.Sprintf("    %s %s NOT NULL", f.Name(), f.SQLType(r.Dialect())))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n);\n", r.Table(), strings.Join(columns, ",\n"))
+}
+
+// DropTableSQL returns the statement dropping the table of the resource.
+func (r *Resource) DropTableSQL() string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", r.Table())
+}
+
+// hasSQLTable reports whether the resource is stored in a table created by the project, not by an ORM.
+func (r *Resource) hasSQLTable() bool {
+	return r.DatabaseDriver != "mongo" && !r.UsesORM()
+}
+
+// Generate writes the files of the resource in the project directory, registers its routes and creates its table
+// with a migration, the ORM or a schema file. It returns the paths of the created and updated files, relative to the project directory.
+func (r *Resource) Generate(projectPath string) ([]string, error) {
+	databaseDir := filepath.Join(projectPath, databasePath)
+	serverDir := filepath.Join(projectPath, serverPath)
+
+	databaseNames, err := declaredNames(databaseDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, declared := range []string{r.Type(), r.Type() + "Repository", r.Type() + "ID"} {
+		if databaseNames[declared] {
+			return nil, fmt.Errorf("%s is already declared in %s", declared, databasePath)
+		}
+	}
+	serverNames, err := declaredNames(serverDir)
+	if err != nil {
+		return nil, err
+	}
+	if serverNames[r.Var()+"Handlers"] {
+		return nil, fmt.Errorf("%sHandlers is already declared in %s", r.Var(), serverPath)
+	}
+
+	routes, err := parseRoutesFile(serverDir, r.Framework)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range r.routes(r.Plural()) {
+		if routes.hasRoute(r.Framework, route) {
+			return nil, fmt.Errorf("the route %s %s is already registered in %s", route.Method, route.Path, routes.path)
+		}
+	}
+	router, err := routes.router(r.Framework)
+	if err != nil {
+		return nil, err
+	}
+	routeStatements, err := r.routeStatements(router, r.Plural())
+	if err != nil {
+		return nil, err
+	}
+	migrationsDir := filepath.Join(projectPath, migrationsPath)
+	var migration string
+	if r.hasSQLTable() && r.HasFeature("migrations") {
+		if migration, err = r.migrationName(migrationsDir); err != nil {
+			return nil, err
+		}
+	}
+	if r.NeedsTable() {
+		if _, err := os.Stat(filepath.Join(projectPath, r.SchemaFile())); err == nil {
+			return nil, fmt.Errorf("the %s table is already created by %s", r.Table(), r.SchemaFile())
+		}
+	}
+
+	var files []string
+	write := func(dir, fileName string, tmpl []byte) error {
+		file, err := createFile(projectPath, dir, fileName, tmpl, r)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	}
+
+	if err := write(databasePath, r.Name()+".go", resource.Repository(r.DatabaseDriver, r.DataAccess)); err != nil {
+		return files, err
+	}
+	if !databaseNames["ErrNotFound"] {
+		if err := write(databasePath, "errors.go", resource.Errors()); err != nil {
+			return files, err
+		}
+	}
+	if usesNetHTTPHandlers(r.Framework) && !serverNames["WriteJSON"] {
+		if err := write(serverPath, "httputil.go", resource.HTTPUtil()); err != nil {
+			return files, err
+		}
+	}
+	if err := write(serverPath, r.Name()+"_handlers.go", resource.Handlers(r.Framework)); err != nil {
+		return files, err
+	}
+	if err := write(serverPath, r.Name()+"_handlers_test.go", resource.HandlersTest()); err != nil {
+		return files, err
+	}
+
+	statements := append([]string{"", fmt.Sprintf("%s := new%sHandlers(%s.db)", r.Plural(), r.Type(), routes.receiver())}, routeStatements...)
+	if err := routes.insertStatements(statements); err != nil {
+		return files, err
+	}
+	files = append(files, relativePath(projectPath, routes.path))
+
+	switch {
+	case r.DataAccess == "gorm":
+		path, err := r.addGormModel(databaseDir)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, relativePath(projectPath, path))
+	case r.DataAccess == "ent":
+		if err := write(entSchemaPath, r.Name()+".go", resource.EntSchema()); err != nil {
+			return files, err
+		}
+		if err := project.EntGenerate(projectPath); err != nil {
+			return files, fmt.Errorf("could not generate the ent code: %v", err)
+		}
+	case migration != "":
+		migrations, err := r.writeMigrations(migrationsDir, migration)
+		if err != nil {
+			return files, err
+		}
+		for _, migration := range migrations {
+			files = append(files, filepath.Join(migrationsPath, migration))
+		}
+	case r.NeedsTable():
+		if err := os.MkdirAll(filepath.Join(projectPath, schemaPath), 0751); err != nil {
+			return files, err
+		}
+		if err := os.WriteFile(filepath.Join(projectPath, r.SchemaFile()), []byte(r.schemaSQL()), 0644); err != nil {
+			return files, err
+		}
+		files = append(files, r.SchemaFile())
+	}
+	return files, nil
+}
+
+// NeedsTable reports whether the table of the resource must be created by hand, which is the case
+// for the SQL databases accessed without ORM when the project does not use the migrations feature.
+// Its statement is written in the schema file of the resource.
+func (r *Resource) NeedsTable() bool {
+	return r.hasSQLTable() && !r.HasFeature("migrations")
+}
+
+// SchemaFile returns the path of the schema file creating the table of the resource in the projects
+// without migrations, relative to the project directory, e.g. internal/database/schema/products.sql.
+func (r *Resource) SchemaFile() string {
+	return filepath.Join(schemaPath, r.Table()+".sql")
+}
+
+// schemaSQL returns the content of the schema file of the resource.
+func (r *Resource) schemaSQL() string {
+	return fmt.Sprintf("-- The %s table, to create in the database before using the %s endpoints.\n%s", r.Table(), r.Path(), r.CreateTableSQL())
+}
+
+// migrationName returns the name of the migration creating the table of the resource, numbered after
+// the existing migrations of the directory, e.g. 0002_create_products.
+func (r *Resource) migrationName(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	version := 0
+	for _, entry := range entries {
+		matches := migrationVersionRegexp.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), "_create_"+r.Table()+".up.sql") {
+			return "", fmt.Errorf("the %s table is already created by the migration %s", r.Table(), entry.Name())
+		}
+		if v, err := strconv.Atoi(matches[1]); err == nil && v > version {
+			version = v
+		}
+	}
+	return fmt.Sprintf("%04d_create_%s", version+1, r.Table()), nil
+}
+
+// writeMigrations writes the up and down migrations of the table of the resource and returns their file names.
+func (r *Resource) writeMigrations(dir, name string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0751); err != nil {
+		return nil, err
+	}
+	migrations := []struct {
+		fileName string
+		content  string
+	}{
+		{name + ".up.sql", r.CreateTableSQL()},
+		{name + ".down.sql", r.DropTableSQL()},
+	}
+	var fileNames []string
+	for _, migration := range migrations {
+		if err := os.WriteFile(filepath.Join(dir, migration.fileName), []byte(migration.content), 0644); err != nil {
+			return fileNames, err
+		}
+		fileNames = append(fileNames, migration.fileName)
+	}
+	return fileNames, nil
+}
+
+// addGormModel adds the model of the resource to the AutoMigrate call of the GORM Migrate function,
+// and returns the path of the updated file.
+func (r *Resource) addGormModel(databaseDir string) (string, error) {
+	file, fn, err := findFunc(databaseDir, "Migrate", false)
+	if err != nil {
+		return "", err
+	}
+	var autoMigrate *ast.CallExpr
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if selector, ok := call.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "AutoMigrate" {
+				autoMigrate = call
+			}
+		}
+		return autoMigrate == nil
+	})
+	if autoMigrate == nil {
+		return "", fmt.Errorf("AutoMigrate call not found in the Migrate function of %s", file.path)
+	}
+
+	model := "&" + r.Type() + "{}"
+	if len(autoMigrate.Args) == 0 {
+		return file.path, file.insert(autoMigrate.Rparen, model)
+	}
+	return file.path, file.insert(autoMigrate.Args[len(autoMigrate.Args)-1].End(), ", "+model)
+}
+
+// relativePath returns the path relative to the project directory, or the path itself when it is outside of it.
+func relativePath(projectPath, path string) string {
+	relative, err := filepath.Rel(projectPath, path)
+	if err != nil {
+		return path
+	}
+	return relative
+}
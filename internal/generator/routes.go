@@ -0,0 +1,234 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SupportedMethods are the HTTP methods of the generated routes.
+var SupportedMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// wildcardRegexp matches the {name} wildcards of a route path.
+var wildcardRegexp = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// routeMethods are the names of the methods registering a route, for each web framework.
+// They are used to find the router in the route registration function.
+var routeMethods = map[string][]string{
+	"standard-library": {"HandleFunc", "Handle"},
+	"chi":              {"Get", "Post", "Put", "Patch", "Delete", "Head", "Options", "Method", "MethodFunc", "HandleFunc", "Handle"},
+	"gorilla/mux":      {"HandleFunc", "Handle"},
+	"httprouter":       {"HandlerFunc", "Handler", "Handle", "GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
+	"gin":              {"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "Any", "Handle"},
+	"echo":             {"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "Any", "Add"},
+	"fiber":            {"Get", "Post", "Put", "Patch", "Delete", "Head", "Options", "All", "Add"},
+}
+
+// Route is an HTTP route of a handler. The path uses {name} wildcards, they are translated to the
+// :name syntax of the frameworks that use it.
+type Route struct {
+	Method  string
+	Path    string
+	Handler string
+}
+
+// registrationFunc returns the name of the method of the server that registers the routes of the web framework.
+func registrationFunc(framework string) string {
+	if framework == "fiber" {
+		return "RegisterFiberRoutes"
+	}
+	return "RegisterRoutes"
+}
+
+// frameworkPath returns the route path in the syntax of the web framework.
+func frameworkPath(framework, path string) string {
+	switch framework {
+	case "httprouter", "gin", "echo", "fiber":
+		return wildcardRegexp.ReplaceAllString(path, ":$1")
+	}
+	return path
+}
+
+// pathParams returns the names of the wildcards of a route path.
+func pathParams(path string) []string {
+	var params []string
+	for _, match := range wildcardRegexp.FindAllStringSubmatch(path, -1) {
+		params = append(params, match[1])
+	}
+	return params
+}
+
+// routeStatement returns the statement registering the route on the router, in the idiom of the web framework.
+func routeStatement(framework, router string, route Route) (string, error) {
+	path := frameworkPath(framework, route.Path)
+	method := strings.ToUpper(route.Method)
+	if !slices.Contains(SupportedMethods, method) {
+		return "", fmt.Errorf("invalid HTTP method: %s. Supported methods are: %s", route.Method, strings.Join(SupportedMethods, ", "))
+	}
+	titleMethod := method[:1] + strings.ToLower(method[1:])
+	httpMethod := "http.Method" + titleMethod
+
+	switch framework {
+	case "standard-library":
+		return fmt.Sprintf("%s.HandleFunc(%q, %s)", router, method+" "+path, route.Handler), nil
+	case "chi", "fiber":
+		return fmt.Sprintf("%s.%s(%q, %s)", router, titleMethod, path, route.Handler), nil
+	case "gorilla/mux":
+		return fmt.Sprintf("%s.HandleFunc(%q, %s).Methods(%s)", router, path, route.Handler, httpMethod), nil
+	case "httprouter":
+		return fmt.Sprintf("%s.HandlerFunc(%s, %q, %s)", router, httpMethod, path, route.Handler), nil
+	case "gin", "echo":
+		return fmt.Sprintf("%s.%s(%q, %s)", router, method, path, route.Handler), nil
+	}
+	return "", fmt.Errorf("unsupported web framework: %s", framework)
+}
+
+// routesFile is the file of the server package declaring the route registration function.
+type routesFile struct {
+	*goFile
+	fn *ast.FuncDecl
+}
+
+// parseRoutesFile finds and parses the file of the server package declaring the route registration function of the framework.
+func parseRoutesFile(serverDir, framework string) (*routesFile, error) {
+	file, fn, err := findFunc(serverDir, registrationFunc(framework), true)
+	if err != nil {
+		return nil, err
+	}
+	return &routesFile{goFile: file, fn: fn}, nil
+}
+
+// receiver returns the name of the receiver of the route registration function.
+func (f *routesFile) receiver() string {
+	if names := f.fn.Recv.List[0].Names; len(names) > 0 {
+		return names[0].Name
+	}
+	return "s"
+}
+
+// router returns the expression of the router the routes are registered on, e.g. r or s.App,
+// taken from the first route registration or else from the first variable declared in the function.
+func (f *routesFile) router(framework string) (string, error) {
+	for _, stmt := range f.fn.Body.List {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		call, ok := exprStmt.X.(*ast.CallExpr)
+		// Unwrap the chained calls such as r.HandleFunc(...).Methods(...)
+		for ok {
+			selector, isSelector := call.Fun.(*ast.SelectorExpr)
+			if !isSelector {
+				break
+			}
+			if slices.Contains(routeMethods[framework], selector.Sel.Name) {
+				return types.ExprString(selector.X), nil
+			}
+			call, ok = selector.X.(*ast.CallExpr)
+		}
+	}
+	for _, stmt := range f.fn.Body.List {
+		if assign, ok := stmt.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE && len(assign.Lhs) == 1 {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+				return ident.Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("could not find the router in %s of %s", f.fn.Name.Name, f.path)
+}
+
+// hasRoute reports whether a route is already registered with the method and path.
+func (f *routesFile) hasRoute(framework string, route Route) bool {
+	method := strings.ToUpper(route.Method)
+	path := frameworkPath(framework, route.Path)
+	found := false
+	ast.Inspect(f.fn.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if callMethod, callPath, ok := registeredRoute(framework, call); ok && callMethod == method && callPath == path {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// registeredRoute returns the method and the path of the route registered by the call, in the idiom of the web framework.
+func registeredRoute(framework string, call *ast.CallExpr) (method, path string, ok bool) {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	name := selector.Sel.Name
+	switch framework {
+	case "standard-library":
+		if (name == "HandleFunc" || name == "Handle") && len(call.Args) > 0 {
+			pattern := stringLiteral(call.Args[0])
+			method, path, found := strings.Cut(pattern, " ")
+			if !found {
+				return "", "", false // patterns without method match every method
+			}
+			return method, strings.TrimSpace(path), true
+		}
+	case "gorilla/mux":
+		inner, isCall := selector.X.(*ast.CallExpr)
+		if name == "Methods" && isCall && len(call.Args) == 1 {
+			if innerSelector, ok := inner.Fun.(*ast.SelectorExpr); ok && innerSelector.Sel.Name != "Methods" && len(inner.Args) > 0 {
+				return httpMethod(call.Args[0]), stringLiteral(inner.Args[0]), true
+			}
+		}
+	case "httprouter":
+		if (name == "HandlerFunc" || name == "Handler" || name == "Handle") && len(call.Args) > 1 {
+			return httpMethod(call.Args[0]), stringLiteral(call.Args[1]), true
+		}
+	}
+	upper := strings.ToUpper(name)
+	if slices.Contains(SupportedMethods, upper) && slices.Contains(routeMethods[framework], name) && len(call.Args) > 0 {
+		return upper, stringLiteral(call.Args[0]), true
+	}
+	return "", "", false
+}
+
+// stringLiteral returns the value of a string literal expression, or an empty string for the other expressions.
+func stringLiteral(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// httpMethod returns the method of a "GET" literal or of an http.MethodGet constant expression.
+func httpMethod(expr ast.Expr) string {
+	if selector, ok := expr.(*ast.SelectorExpr); ok && strings.HasPrefix(selector.Sel.Name, "Method") {
+		return strings.ToUpper(strings.TrimPrefix(selector.Sel.Name, "Method"))
+	}
+	return strings.ToUpper(stringLiteral(expr))
+}
+
+// insertStatements inserts the statements at the end of the route registration function, before its
+// return statement, and writes the file back. An empty statement leaves a blank line.
+func (f *routesFile) insertStatements(statements []string) error {
+	body := f.fn.Body
+	var last ast.Node = &ast.BlockStmt{Lbrace: body.Lbrace, Rbrace: body.Lbrace}
+	for _, stmt := range body.List {
+		if _, ok := stmt.(*ast.ReturnStmt); ok {
+			break
+		}
+		last = stmt
+	}
+	pos, err := f.lineAfter(last)
+	if err != nil {
+		return err
+	}
+	return f.insert(pos, strings.Join(statements, "\n")+"\n")
+}
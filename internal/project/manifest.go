@@ -0,0 +1,90 @@
+// Package project provides the functionality for creating a new Go project.
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ManifestFile is the file, at the root of a generated project, recording how the project was generated.
+// It is read by the generate commands to produce code for the framework and database of the project.
+const ManifestFile = ".goforge.json"
+
+// ErrManifestNotFound is returned by ReadManifest when the project directory has no manifest file.
+var ErrManifestNotFound = errors.New(ManifestFile + " not found")
+
+// Manifest records the choices a project was generated with.
+type Manifest struct {
+	Module         string   `json:"module"`
+	Framework      string   `json:"framework"`
+	DatabaseDriver string   `json:"databaseDriver"`
+	DataAccess     string   `json:"dataAccess,omitempty"`
+	SQLiteBackend  string   `json:"sqliteBackend,omitempty"`
+	Features       []string `json:"features,omitempty"`
+	Profiles       []string `json:"profiles,omitempty"`
+	Docker         string   `json:"docker,omitempty"`
+	Deploy         string   `json:"deploy,omitempty"`
+	DevContainer   string   `json:"devContainer,omitempty"`
+	GoVersion      string   `json:"goVersion"`
+}
+
+// Manifest returns the manifest of the project.
+func (p *ProjectConfig) Manifest() Manifest {
+	return Manifest{
+		Module:         p.ProjectName,
+		Framework:      p.ProjectType,
+		DatabaseDriver: p.DatabaseDriver,
+		DataAccess:     p.DataAccess,
+		SQLiteBackend:  p.SQLiteBackend,
+		Features:       p.Features,
+		Profiles:       p.Profiles,
+		Docker:         p.Docker,
+		Deploy:         p.Deploy,
+		DevContainer:   p.DevContainer,
+		GoVersion:      p.GoVersion,
+	}
+}
+
+// HasFeature reports whether the project was generated with the optional feature.
+func (m Manifest) HasFeature(feature string) bool {
+	return hasFeature(m.Features, feature)
+}
+
+// UsesORM reports whether the project accesses its SQL database through an ORM instead of raw database/sql.
+func (m Manifest) UsesORM() bool {
+	return usesORM(m.DataAccess)
+}
+
+// SupportsServeMuxPatterns reports whether the project Go version supports method and wildcard
+// patterns in http.ServeMux.
+func (m Manifest) SupportsServeMuxPatterns() bool {
+	return supportsServeMuxPatterns(m.GoVersion)
+}
+
+// WriteManifest writes the manifest file in the project directory.
+func WriteManifest(projectPath string, manifest Manifest) error {
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(projectPath, ManifestFile), append(content, '\n'), 0644)
+}
+
+// ReadManifest reads the manifest file of the project directory.
+func ReadManifest(projectPath string) (Manifest, error) {
+	var manifest Manifest
+	content, err := os.ReadFile(filepath.Join(projectPath, ManifestFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, fmt.Errorf("%w in %s, run the command from the root of a project created by goforge", ErrManifestNotFound, projectPath)
+	}
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid %s: %v", ManifestFile, err)
+	}
+	return manifest, nil
+}
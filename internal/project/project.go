@@ -4,16 +4,24 @@ package project
 import (
 	"bytes"
 	"fmt"
-	"html/template"
 	"log"
+	"maps"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"text/template"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/spf13/cobra"
+	"github.com/tz3/goforge/internal/envfile"
 	tpl "github.com/tz3/goforge/internal/templates"
+	"github.com/tz3/goforge/internal/templates/cache"
 	"github.com/tz3/goforge/internal/templates/db"
+	"github.com/tz3/goforge/internal/templates/deploy"
 	"github.com/tz3/goforge/internal/templates/docker"
 	"github.com/tz3/goforge/internal/templates/web"
 )
@@ -25,8 +33,17 @@ type ProjectConfig struct {
 	ProjectName       string
 	ProjectType       string
 	DatabaseDriver    string
+	DataAccess        string
+	SQLiteBackend     string
+	GoVersion         string
+	Features          []string
+	Profiles          []string
 	Docker            string
+	Deploy            string
+	DevContainer      string
+	Credentials       envfile.Credentials       // the random local credentials written in .env
 	DatabaseDriverMap map[string]DatabaseDriver // can be any of the supported Db Drivers
+	DataAccessMap     map[string]DataAccess     // can be any of the supported ORMs
 	FrameworkMap      map[string]WebFramework   // Can be any of the supported router Packages.
 	DockerMap         map[string]Docker         // can be any of the supported Db Drivers
 	Exit              bool
@@ -47,6 +64,14 @@ type DatabaseDriver struct {
 	templateGen  DBDriverTemplateGenerator
 }
 
+// DataAccess represents an ORM that can be used to access the SQL databases instead of raw database/sql.
+// It includes the dependencies of the ORM, the dependencies for each database driver and a template generator.
+type DataAccess struct {
+	dependencies       []string
+	driverDependencies map[string][]string
+	templateGen        DataAccessTemplateGenerator
+}
+
 // Docker represents a dockerfile that can be used in the project.
 // It includes the dependencies of the driver and a template generator.
 type Docker struct {
@@ -64,12 +89,49 @@ type WebFrameworkTemplateGenerator interface {
 	ServerWithDB() []byte
 }
 
+// HTTPUtilTemplateGenerator is implemented by the web frameworks whose routes rely on generated HTTP helpers,
+// such as the middleware chaining and JSON helpers of the standard library router.
+type HTTPUtilTemplateGenerator interface {
+	HTTPUtil() []byte
+}
+
 type DBDriverTemplateGenerator interface {
 	Service() []byte
 	Env() []byte
 	EnvExample() []byte
 }
 
+// MigrationsTemplateGenerator is implemented by the database drivers that support the migrations feature.
+// Migrate is the migration runner, MigrateCmd the command that runs it and Migrations the initial
+// migration files keyed by file name, which is empty for the drivers without a schema.
+type MigrationsTemplateGenerator interface {
+	Migrate() []byte
+	MigrateCmd() []byte
+	Migrations() map[string][]byte
+}
+
+// SQLCTemplateGenerator is implemented by the database drivers that support the sqlc feature.
+// SQLCConfig is the sqlc.yaml configuration, Queries the sample queries and SQLCCode the code
+// generated by sqlc for them, both keyed by file name.
+type SQLCTemplateGenerator interface {
+	SQLCConfig() []byte
+	Queries() map[string][]byte
+	SQLCCode() map[string][]byte
+}
+
+// DataAccessTemplateGenerator is implemented by the ORMs, Repository is the connection setup,
+// the sample model and its repository and the Migrate function.
+type DataAccessTemplateGenerator interface {
+	Repository() []byte
+}
+
+// EntTemplateGenerator is implemented by the ORMs that generate their code from a schema,
+// Schema is the schema of the sample entity and Generate the go:generate directive of the code generator.
+type EntTemplateGenerator interface {
+	Schema() []byte
+	Generate() []byte
+}
+
 type DockerTemplateGenerator interface {
 	Docker() []byte
 }
@@ -77,7 +139,7 @@ type DockerTemplateGenerator interface {
 // Supported Web framework, and DB driver and its dependencies.
 var (
 	SupportedWebframeworks   = []string{"chi", "echo", "fiber", "gin", "gorilla/mux", "httprouter", "standard-library"}
-	SupportedDatabaseDrivers = []string{"mysql", "postgres", "sqlite", "mongo", "none"}
+	SupportedDatabaseDrivers = []string{"mysql", "postgres", "sqlite", "sqlserver", "cockroachdb", "libsql", "mongo", "redis", "none"}
 	chiDependencies          = []string{"github.com/go-chi/chi/v5"}
 	gorillaDependencies      = []string{"github.com/gorilla/mux"}
 	routerDependencies       = []string{"github.com/julienschmidt/httprouter"}
@@ -86,21 +148,159 @@ var (
 	echoDependencies         = []string{"github.com/labstack/echo/v4", "github.com/labstack/echo/v4/middleware"}
 	mysqlDependencies        = []string{"github.com/go-sql-driver/mysql"}
 	postgresDependencies     = []string{"github.com/jackc/pgx/v5"}
-	sqliteDependencies       = []string{"github.com/mattn/go-sqlite3"}
+	sqlserverDependencies    = []string{"github.com/microsoft/go-mssqldb"}
+	cockroachdbDependencies  = []string{"github.com/jackc/pgx/v5", "github.com/cockroachdb/cockroach-go/v2/crdb"}
+	libsqlDependencies       = []string{"github.com/tursodatabase/libsql-client-go/libsql"}
 	mongoDependencies        = []string{"go.mongodb.org/mongo-driver"}
+	redisDependencies        = []string{"github.com/redis/go-redis/v9"}
 	godotenvDependencies     = []string{"github.com/joho/godotenv"}
 )
 
+// Supported data access styles of the SQL database drivers, raw database/sql or an ORM, and the ORM dependencies.
+var (
+	SupportedDataAccess       = []string{"raw", "gorm", "ent"}
+	dataAccessDatabaseDrivers = []string{"mysql", "postgres", "sqlite"}
+	gormDependencies          = []string{"gorm.io/gorm"}
+	gormDriverDependencies    = map[string][]string{
+		"mysql":    {"gorm.io/driver/mysql"},
+		"postgres": {"gorm.io/driver/postgres"},
+	}
+	// The ent code generator loads the schemas with golang.org/x/tools, whose version required by ent cannot load
+	// the packages of newer Go toolchains.
+	entDependencies = []string{"entgo.io/ent", "entgo.io/ent/cmd/ent", "golang.org/x/tools@latest"}
+)
+
+// Supported SQLite backends and their dependencies: cgo links the SQLite C library with go-sqlite3, pure-go
+// is modernc.org/sqlite, a translation of SQLite to Go that builds with CGO_ENABLED=0.
+var (
+	SupportedSQLiteBackends   = []string{"cgo", "pure-go"}
+	sqliteBackendDependencies = map[string][]string{
+		"cgo":     {"github.com/mattn/go-sqlite3"},
+		"pure-go": {"modernc.org/sqlite"},
+	}
+	gormSQLiteBackendDependencies = map[string][]string{
+		"cgo":     {"gorm.io/driver/sqlite"},
+		"pure-go": {"github.com/glebarez/sqlite"},
+	}
+)
+
+// Supported optional features, the database drivers and data access styles each of them can be used with
+// and the features each of them requires.
+var (
+	SupportedFeatures      = []string{"migrations", "sqlc", "cache"}
+	featureDatabaseDrivers = map[string][]string{
+		"migrations": {"mysql", "postgres", "sqlite", "mongo"},
+		"sqlc":       {"mysql", "postgres", "sqlite"},
+		"cache":      {"mysql", "postgres", "sqlite", "sqlserver", "cockroachdb", "libsql"},
+	}
+	featureDataAccess = map[string][]string{
+		"migrations": {"raw"}, // the ORMs create the schema with their own Migrate
+		"sqlc":       {"raw"},
+		"cache":      {"raw", "gorm", "ent"},
+	}
+	featureDependencies = map[string][]string{
+		"sqlc": {"migrations"}, // sqlc reads the schema from the migrations
+	}
+	cacheDependencies = []string{"github.com/redis/go-redis/v9"}
+)
+
+// SupportedDocker are the container setups of the generated projects: compose generates a Dockerfile and
+// docker-compose files run by Docker, podman the same files in the idiom of Podman, and none no container file.
+var SupportedDocker = []string{"compose", "podman", "none"}
+
+// SupportedDeploy are the deployment targets of the generated projects: k8s generates Kubernetes manifests with
+// kustomize overlays in deploy/k8s, helm a Helm chart in charts/<image name>, none no deployment file.
+var SupportedDeploy = []string{"none", "k8s", "helm"}
+
+// SupportedDevContainer are the dev container setups of the generated projects: compose generates a dev container
+// in .devcontainer, run next to the services of docker-compose.yml, none no dev container.
+var SupportedDevContainer = []string{"none", "compose"}
+
+// devContainerImage is the Go image of the devcontainers project, tagged by Go release.
+const devContainerImage = "mcr.microsoft.com/devcontainers/go"
+
+// databaseExtensions are the editor extensions browsing the databases of the database drivers.
+var databaseExtensions = map[string][]string{
+	"mysql":       {"mtxr.sqltools", "mtxr.sqltools-driver-mysql"},
+	"postgres":    {"mtxr.sqltools", "mtxr.sqltools-driver-pg"},
+	"sqlite":      {"mtxr.sqltools", "mtxr.sqltools-driver-sqlite"},
+	"sqlserver":   {"ms-mssql.mssql"},
+	"cockroachdb": {"mtxr.sqltools", "mtxr.sqltools-driver-pg"},
+	"mongo":       {"mongodb.mongodb-vscode"},
+	"redis":       {"redis.redis-for-vscode"},
+}
+
+// k8sOverlays are the kustomize overlays of the Kubernetes manifests and the environment profile each one runs with.
+var k8sOverlays = map[string]string{
+	"dev":  "staging",
+	"prod": "production",
+}
+
+// SupportedProfiles are the environments of the generated projects, each profile is scaffolded as a .env.<profile>
+// overlay of .env with a run-<profile> Makefile target.
+var SupportedProfiles = []string{"local", "test", "staging", "production"}
+
+// goVersionRegexp matches Go release versions such as 1.22 or 1.22.3.
+var goVersionRegexp = regexp.MustCompile(`^1\.(\d+)(\.\d+)?$`)
+
+// localGoVersionRegexp matches the release part of a local toolchain version such as 1.22.3 or 1.23rc1.
+var localGoVersionRegexp = regexp.MustCompile(`^1\.\d+(\.\d+)?`)
+
+// databaseServices are the docker-compose services of the database drivers with a server, their port and their
+// pinned image, shared by docker-compose and the Helm chart.
+var databaseServices = map[string]struct {
+	name  string
+	port  int
+	image string
+}{
+	"mysql":       {"mysql", 3306, "mysql:8.4"},
+	"postgres":    {"psql", 5432, "postgres:16.4-alpine"},
+	"sqlserver":   {"mssql", 1433, "mcr.microsoft.com/mssql/server:2022-CU14-ubuntu-22.04"},
+	"cockroachdb": {"cockroachdb", 26257, "cockroachdb/cockroach:v24.2.4"},
+	"libsql":      {"libsql", 8080, "ghcr.io/tursodatabase/libsql-server:v0.24.32"},
+	"mongo":       {"mongo", 27017, "mongo:7.0"},
+	"redis":       {"redis", 6379, "redis:7.4-alpine"},
+}
+
+// cacheImage is the pinned image of the Redis server of the cache feature.
+const cacheImage = "redis:7.4-alpine"
+
+// imageNameRegexp matches the characters not allowed in the name of a Docker image.
+var imageNameRegexp = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// minimumGoMinorVersion is the oldest Go 1.x release supported by the generated templates,
+// it is the first release that understands the toolchain directive in go.mod.
+const minimumGoMinorVersion = 21
+
 // File paths and names.
 const (
 	root                 = "/"
 	cmdApiPath           = "cmd/api"
 	internalServerPath   = "internal/server"
 	internalDatabasePath = "internal/database"
+	internalHealthPath   = "internal/health"
+	internalConfigPath   = "internal/config"
+	migrationsPath       = "internal/database/migrations"
+	cmdMigratePath       = "cmd/migrate"
+	queriesPath          = "internal/database/queries"
+	sqlcPath             = "internal/database/sqlc"
+	entPath              = "internal/ent"
+	internalCachePath    = "internal/cache"
+	entSchemaPath        = "internal/ent/schema"
+	k8sBasePath          = "deploy/k8s/base"
+	k8sOverlaysPath      = "deploy/k8s/overlays"
+	helmChartsPath       = "charts"
+	devContainerPath     = ".devcontainer"
 	mainFile             = "main.go"
 	databaseFile         = "database.go"
 	serverFile           = "server.go"
 	routesFile           = "routes.go"
+	httpUtilFile         = "httputil.go"
+	healthFile           = "health.go"
+	environmentFile      = "environment.go"
+	migrateFile          = "migrate.go"
+	repositoryFile       = "repository.go"
+	generateFile         = "generate.go"
 )
 
 // ExitCLI releases the terminal and exits the program if the Exit flag is set.
@@ -165,13 +365,46 @@ func (p *ProjectConfig) createDatabaseDriverMap() {
 		templateGen:  db.PostgresTemplate{},
 	}
 	p.DatabaseDriverMap["sqlite"] = DatabaseDriver{
-		dependencies: sqliteDependencies,
+		dependencies: sqliteBackendDependencies[p.sqliteBackend()],
 		templateGen:  db.SqliteTemplate{},
 	}
+	p.DatabaseDriverMap["sqlserver"] = DatabaseDriver{
+		dependencies: sqlserverDependencies,
+		templateGen:  db.SqlServerTemplate{},
+	}
+	p.DatabaseDriverMap["cockroachdb"] = DatabaseDriver{
+		dependencies: cockroachdbDependencies,
+		templateGen:  db.CockroachDBTemplate{},
+	}
+	p.DatabaseDriverMap["libsql"] = DatabaseDriver{
+		dependencies: libsqlDependencies,
+		templateGen:  db.LibSQLTemplate{},
+	}
 	p.DatabaseDriverMap["mongo"] = DatabaseDriver{
 		dependencies: mongoDependencies,
 		templateGen:  db.MongoTemplate{},
 	}
+	p.DatabaseDriverMap["redis"] = DatabaseDriver{
+		dependencies: redisDependencies,
+		templateGen:  db.RedisTemplate{},
+	}
+}
+
+// createDataAccessMap initializes the DataAccessMap with the available ORMs.
+func (p *ProjectConfig) createDataAccessMap() {
+	p.DataAccessMap = make(map[string]DataAccess)
+
+	gormDrivers := maps.Clone(gormDriverDependencies)
+	gormDrivers["sqlite"] = gormSQLiteBackendDependencies[p.sqliteBackend()]
+	p.DataAccessMap["gorm"] = DataAccess{
+		dependencies:       gormDependencies,
+		driverDependencies: gormDrivers,
+		templateGen:        db.GormTemplate{},
+	}
+	p.DataAccessMap["ent"] = DataAccess{
+		dependencies: entDependencies,
+		templateGen:  db.EntTemplate{},
+	}
 }
 
 // Todo: Fix me:- function is too long, decompose this function into smaller ones.
@@ -211,9 +444,16 @@ func (p *ProjectConfig) CreateMainFile() error {
 		cobra.CheckErr(err)
 	}
 
+	// Pin the go and toolchain directives to the requested Go version
+	err = setGoVersion(projectPath, p.GoVersion)
+	if err != nil {
+		log.Printf("Could not set the Go version in go.mod %v\n", err)
+		cobra.CheckErr(err)
+	}
+
 	// Install the correct package for the selected framework
 	if p.ProjectType != "standard library" {
-		err = goGetDependencies(projectPath, p.FrameworkMap[p.ProjectType].dependencies)
+		err = goGetDependencies(projectPath, p.GoVersion, p.FrameworkMap[p.ProjectType].dependencies)
 		if err != nil {
 			log.Printf("Could not install go dependency for the chosen framework %v\n", err)
 			cobra.CheckErr(err)
@@ -223,12 +463,22 @@ func (p *ProjectConfig) CreateMainFile() error {
 	// Install the correct package for the selected driver
 	if p.DatabaseDriver != "none" {
 		p.createDatabaseDriverMap()
-		err = goGetDependencies(projectPath, p.DatabaseDriverMap[p.DatabaseDriver].dependencies)
+		err = goGetDependencies(projectPath, p.GoVersion, p.DatabaseDriverMap[p.DatabaseDriver].dependencies)
 		if err != nil {
 			log.Printf("Could not install go dependency for chosen driver %v\n", err)
 			cobra.CheckErr(err)
 		}
 
+		if p.UsesORM() {
+			p.createDataAccessMap()
+			dataAccess := p.DataAccessMap[p.DataAccess]
+			err = goGetDependencies(projectPath, p.GoVersion, slices.Concat(dataAccess.dependencies, dataAccess.driverDependencies[p.DatabaseDriver]))
+			if err != nil {
+				log.Printf("Could not install go dependency for chosen data access %v\n", err)
+				cobra.CheckErr(err)
+			}
+		}
+
 		err = p.createPath(internalDatabasePath, projectPath)
 		if err != nil {
 			log.Printf("Error creating path: %s", internalDatabasePath)
@@ -242,35 +492,52 @@ func (p *ProjectConfig) CreateMainFile() error {
 			cobra.CheckErr(err)
 			return err
 		}
-	}
 
-	// Create correct docker compose for the selected driver
-	if p.DatabaseDriver != "none" {
+		if p.UsesORM() {
+			err = p.createDataAccess(projectPath)
+			if err != nil {
+				log.Printf("Error injecting the %s data access: %v", p.DataAccess, err)
+				cobra.CheckErr(err)
+				return err
+			}
+		}
 
-		err = p.createFileAndWriteTemplate(root, projectPath, ".env.example", "env-example")
-		if err != nil {
-			log.Printf("Error injecting .env.example file: %v", err)
-			cobra.CheckErr(err)
-			return err
+		if p.HasFeature("migrations") {
+			err = p.createMigrations(projectPath)
+			if err != nil {
+				log.Printf("Error injecting migrations: %v", err)
+				cobra.CheckErr(err)
+				return err
+			}
 		}
 
-		if p.DatabaseDriver != "sqlite" {
-			p.createDockerMap()
-			p.Docker = p.DatabaseDriver
+		if p.HasFeature("sqlc") {
+			err = p.createSQLC(projectPath)
+			if err != nil {
+				log.Printf("Error injecting sqlc files: %v", err)
+				cobra.CheckErr(err)
+				return err
+			}
+		}
+
+		if p.HasFeature("cache") {
+			err = goGetDependencies(projectPath, p.GoVersion, cacheDependencies)
+			if err != nil {
+				log.Printf("Could not install go dependency for the cache %v\n", err)
+				cobra.CheckErr(err)
+			}
 
-			err = p.createFileAndWriteTemplate(root, projectPath, "docker-compose.yml", "docker-compose")
+			err = p.createCache(projectPath)
 			if err != nil {
-				log.Printf("Error injecting docker-compose.yml file: %v", err)
+				log.Printf("Error injecting cache files: %v", err)
 				cobra.CheckErr(err)
 				return err
 			}
-		} else {
-			fmt.Println("We are unable to create docker-compose.yml file for an SQLite database")
 		}
 	}
 
 	// Install the godotenv package
-	err = goGetDependencies(projectPath, godotenvDependencies)
+	err = goGetDependencies(projectPath, p.GoVersion, godotenvDependencies)
 	if err != nil {
 		log.Printf("Could not install go dependency %v\n", err)
 		cobra.CheckErr(err)
@@ -353,6 +620,73 @@ func (p *ProjectConfig) CreateMainFile() error {
 		}
 	}
 
+	if _, ok := p.FrameworkMap[p.ProjectType].templateGen.(HTTPUtilTemplateGenerator); ok {
+		err = p.createFileAndWriteTemplate(internalServerPath, projectPath, httpUtilFile, "httputil")
+		if err != nil {
+			log.Printf("Error injecting httputil.go file: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
+	if p.ProjectType != "fiber" {
+		err = p.createFileAndWriteTemplate(internalServerPath, projectPath, environmentFile, "environment")
+		if err != nil {
+			log.Printf("Error injecting environment.go file: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+
+		err = p.createFileAndWriteTemplate(internalServerPath, projectPath, "environment_test.go", "environmentTest")
+		if err != nil {
+			log.Printf("Error injecting environment_test.go file: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
+	err = p.createPath(internalHealthPath, projectPath)
+	if err != nil {
+		log.Printf("Error creating path: %s", internalHealthPath)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createFileAndWriteTemplate(internalHealthPath, projectPath, healthFile, "health")
+	if err != nil {
+		log.Printf("Error injecting health.go file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createPath(internalConfigPath, projectPath)
+	if err != nil {
+		log.Printf("Error creating path: %s", internalConfigPath)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createFileAndWriteTemplate(internalConfigPath, projectPath, "config.go", "config")
+	if err != nil {
+		log.Printf("Error injecting config.go file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createFileAndWriteTemplate(internalConfigPath, projectPath, "config_test.go", "configTest")
+	if err != nil {
+		log.Printf("Error injecting config_test.go file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	p.Credentials, err = envfile.NewCredentials(p.ProjectName)
+	if err != nil {
+		log.Printf("Error generating the local credentials: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
 	err = p.createFileAndWriteTemplate(root, projectPath, ".env", "env")
 	if err != nil {
 		log.Printf("Error injecting .env file: %v", err)
@@ -360,6 +694,40 @@ func (p *ProjectConfig) CreateMainFile() error {
 		return err
 	}
 
+	err = p.createFileAndWriteTemplate(root, projectPath, ".env.example", "env-example")
+	if err != nil {
+		log.Printf("Error injecting .env.example file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	for _, profile := range p.Profiles {
+		err = p.createFileAndWriteTemplate(root, projectPath, ".env."+profile, "env-profile")
+		if err != nil {
+			log.Printf("Error injecting .env.%s file: %v", profile, err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
+	if p.Deploy == "k8s" {
+		err = p.createK8s(projectPath)
+		if err != nil {
+			log.Printf("Error injecting Kubernetes manifests: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
+	if p.Deploy == "helm" {
+		err = p.createHelm(projectPath)
+		if err != nil {
+			log.Printf("Error injecting Helm chart: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
 	// Initialize git repo
 	err = initGitRepo(projectPath)
 	if err != nil {
@@ -412,9 +780,337 @@ func (p *ProjectConfig) CreateMainFile() error {
 		cobra.CheckErr(err)
 	}
 
+	// go mod tidy adds the missing dependencies at their newest version, which may require a newer Go version
+	err = pinGoVersion(projectPath, p.GoVersion)
+	if err != nil {
+		log.Printf("Could not pin the Go version in go.mod %v\n", err)
+		cobra.CheckErr(err)
+	}
+
+	// Drop the checksums of the downgraded dependencies
+	err = goTidy(projectPath)
+	if err != nil {
+		log.Printf("Could not go tidy in new project %v\n", err)
+		cobra.CheckErr(err)
+	}
+
+	// The go directive may name the release of the requested version, e.g. 1.22.0 for 1.22
+	p.GoVersion, err = goModGoVersion(projectPath)
+	if err != nil {
+		log.Printf("Could not read the Go version from go.mod %v\n", err)
+		cobra.CheckErr(err)
+	}
+
+	// Record the choices of the project for the generate commands
+	err = WriteManifest(projectPath, p.Manifest())
+	if err != nil {
+		log.Printf("Error injecting %s file: %v", ManifestFile, err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	// The container files are only generated with the compose and podman setups
+	if !p.HasDocker() {
+		return nil
+	}
+
+	err = p.createFileAndWriteTemplate(root, projectPath, "Dockerfile", "dockerfile")
+	if err != nil {
+		log.Printf("Error injecting Dockerfile file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createFileAndWriteTemplate(root, projectPath, ".dockerignore", "dockerignore")
+	if err != nil {
+		log.Printf("Error injecting .dockerignore file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	// The docker-compose file runs the application with the services of the database driver and of the cache
+	p.createDockerMap()
+	err = p.createFileAndWriteTemplate(root, projectPath, "docker-compose.yml", "docker-compose")
+	if err != nil {
+		log.Printf("Error injecting docker-compose.yml file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	err = p.createFileAndWriteTemplate(root, projectPath, "docker-compose.override.yml", "docker-compose-override")
+	if err != nil {
+		log.Printf("Error injecting docker-compose.override.yml file: %v", err)
+		cobra.CheckErr(err)
+		return err
+	}
+
+	if p.DevContainer == "compose" {
+		err = p.createPath(devContainerPath, projectPath)
+		if err != nil {
+			log.Printf("Error creating path: %s", devContainerPath)
+			cobra.CheckErr(err)
+			return err
+		}
+
+		err = p.createFileAndWriteTemplate(devContainerPath, projectPath, "devcontainer.json", "devcontainer")
+		if err != nil {
+			log.Printf("Error injecting devcontainer.json file: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+
+		err = p.createFileAndWriteTemplate(devContainerPath, projectPath, "docker-compose.yml", "devcontainer-compose")
+		if err != nil {
+			log.Printf("Error injecting the docker-compose.yml file of the dev container: %v", err)
+			cobra.CheckErr(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createMigrations creates the migration runner of the database driver, the initial migrations
+// and the cmd/migrate command.
+func (p *ProjectConfig) createMigrations(projectPath string) error {
+	templateGen := p.DatabaseDriverMap[p.DatabaseDriver].templateGen.(MigrationsTemplateGenerator)
+
+	err := p.createFileAndWriteTemplate(internalDatabasePath, projectPath, migrateFile, "migrate")
+	if err != nil {
+		return err
+	}
+
+	migrations := templateGen.Migrations()
+	if len(migrations) > 0 {
+		err = p.createPath(migrationsPath, projectPath)
+		if err != nil {
+			return err
+		}
+		for fileName, content := range migrations {
+			err = os.WriteFile(fmt.Sprintf("%s/%s/%s", projectPath, migrationsPath, fileName), content, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = p.createPath(cmdMigratePath, projectPath)
+	if err != nil {
+		return err
+	}
+	return p.createFileAndWriteTemplate(cmdMigratePath, projectPath, mainFile, "migrateCmd")
+}
+
+// createDataAccess creates the repository of the ORM and, for the ORMs generating their code
+// from a schema, the schema of the sample entity before running the code generator.
+func (p *ProjectConfig) createDataAccess(projectPath string) error {
+	err := p.createFileAndWriteTemplate(internalDatabasePath, projectPath, repositoryFile, "repository")
+	if err != nil {
+		return err
+	}
+
+	if _, ok := p.DataAccessMap[p.DataAccess].templateGen.(EntTemplateGenerator); !ok {
+		return nil
+	}
+	err = p.createPath(entSchemaPath, projectPath)
+	if err != nil {
+		return err
+	}
+	err = p.createFileAndWriteTemplate(entPath, projectPath, generateFile, "entGenerate")
+	if err != nil {
+		return err
+	}
+	err = p.createFileAndWriteTemplate(entSchemaPath, projectPath, "user.go", "entSchema")
+	if err != nil {
+		return err
+	}
+	return EntGenerate(projectPath)
+}
+
+// createSQLC creates the sqlc configuration, the sample queries and the code generated by sqlc for them.
+func (p *ProjectConfig) createSQLC(projectPath string) error {
+	templateGen := p.DatabaseDriverMap[p.DatabaseDriver].templateGen.(SQLCTemplateGenerator)
+
+	err := os.WriteFile(fmt.Sprintf("%s/sqlc.yaml", projectPath), templateGen.SQLCConfig(), 0644)
+	if err != nil {
+		return err
+	}
+
+	for path, files := range map[string]map[string][]byte{
+		queriesPath: templateGen.Queries(),
+		sqlcPath:    templateGen.SQLCCode(),
+	} {
+		err = p.createPath(path, projectPath)
+		if err != nil {
+			return err
+		}
+		for fileName, content := range files {
+			err = os.WriteFile(fmt.Sprintf("%s/%s/%s", projectPath, path, fileName), content, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createCache creates the cache package with its Redis and in-memory implementations.
+func (p *ProjectConfig) createCache(projectPath string) error {
+	err := p.createPath(internalCachePath, projectPath)
+	if err != nil {
+		return err
+	}
+	for fileName, content := range cache.Files() {
+		createdFile, err := os.Create(fmt.Sprintf("%s/%s/%s", projectPath, internalCachePath, fileName))
+		if err != nil {
+			return err
+		}
+		err = template.Must(template.New(fileName).Parse(string(content))).Execute(createdFile, p)
+		createdFile.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createK8s creates the Kubernetes manifests: the kustomize base in deploy/k8s/base, with the variables of
+// .env.example read by the config package, and an overlay per environment in deploy/k8s/overlays.
+func (p *ProjectConfig) createK8s(projectPath string) error {
+	config, secrets, err := deploymentVariables(projectPath)
+	if err != nil {
+		return err
+	}
+
+	manifests := k8sManifests{ProjectConfig: p, Config: config, Secrets: secrets}
+	err = p.createPath(k8sBasePath, projectPath)
+	if err != nil {
+		return err
+	}
+	for fileName, content := range deploy.K8sBaseTemplates() {
+		if (fileName == "secret.yaml" && len(secrets) == 0) || (fileName == "pvc.yaml" && p.DatabaseDriver != "sqlite") {
+			continue
+		}
+		err = createFileFromTemplate(fmt.Sprintf("%s/%s/%s", projectPath, k8sBasePath, fileName), content, "{{", "}}", manifests)
+		if err != nil {
+			return err
+		}
+	}
+
+	for overlay, profile := range k8sOverlays {
+		overlayPath := fmt.Sprintf("%s/%s", k8sOverlaysPath, overlay)
+		err = p.createPath(overlayPath, projectPath)
+		if err != nil {
+			return err
+		}
+		manifests.Overlay, manifests.Profile = overlay, profile
+		for fileName, content := range deploy.K8sOverlayTemplates() {
+			err = createFileFromTemplate(fmt.Sprintf("%s/%s/%s", projectPath, overlayPath, fileName), content, "{{", "}}", manifests)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// createHelm creates the Helm chart in charts/<image name>, with the variables of .env.example read by the config
+// package as its values and toggles running the database and the cache in the cluster.
+func (p *ProjectConfig) createHelm(projectPath string) error {
+	config, secrets, err := deploymentVariables(projectPath)
+	if err != nil {
+		return err
+	}
+	local, err := envfile.Read(fmt.Sprintf("%s/%s", projectPath, envfile.EnvFile))
+	if err != nil {
+		return err
+	}
+
+	// The placeholders of the settings, such as the name of the database, default to their local value, which
+	// the database run by the chart is created with. The secrets are left empty, the chart generates them.
+	for i, variable := range config {
+		value, _ := local.Value(variable.Key)
+		if !strings.Contains(variable.Value, "<") || value == "" || value == "localhost" || strings.Contains(value, "://localhost") {
+			continue
+		}
+		config[i].Value = value
+	}
+	for i := range secrets {
+		secrets[i].Value = ""
+	}
+
+	chart := helmChart{ProjectConfig: p, Config: config, Secrets: secrets}
+	chartPath := fmt.Sprintf("%s/%s", helmChartsPath, p.ImageName())
+	err = p.createPath(chartPath+"/templates", projectPath)
+	if err != nil {
+		return err
+	}
+	for fileName, content := range deploy.HelmChartTemplates() {
+		switch {
+		case fileName == "templates/secret.yaml" && len(secrets) == 0,
+			fileName == "templates/pvc.yaml" && p.DatabaseDriver != "sqlite",
+			fileName == "templates/hpa.yaml" && p.DatabaseDriver == "sqlite",
+			fileName == "templates/database.yaml" && !chart.InClusterDatabase(),
+			fileName == "templates/cache.yaml" && !p.HasFeature("cache"):
+			continue
+		}
+
+		// The chart templates keep the {{ }} actions for Helm.
+		err = createFileFromTemplate(fmt.Sprintf("%s/%s/%s", projectPath, chartPath, fileName), content, "[[", "]]", chart)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createFileFromTemplate creates the file with the output of the template, whose actions are delimited by
+// leftDelim and rightDelim.
+func createFileFromTemplate(path string, content []byte, leftDelim, rightDelim string, data any) error {
+	createdFile, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer createdFile.Close()
+
+	tmpl := template.Must(template.New(filepath.Base(path)).Delims(leftDelim, rightDelim).Parse(string(content)))
+	return tmpl.Execute(createdFile, data)
+}
+
+// deploymentVariables returns the variables of .env.example read by the config package, split between the
+// settings and the secrets, with the values of .env.example. The deployed application runs with the defaults of
+// its production profile and does not reach the services on localhost, whose addresses become placeholders.
+func deploymentVariables(projectPath string) (config, secrets []envVariable, err error) {
+	example, err := envfile.Read(fmt.Sprintf("%s/%s", projectPath, envfile.ExampleFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	configKeys, err := envfile.ConfigKeys(fmt.Sprintf("%s/%s", projectPath, envfile.ConfigFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, key := range example.Keys() {
+		if !slices.Contains(configKeys, key) {
+			continue
+		}
+		value, _ := example.Value(key)
+		switch {
+		case key == "APP_ENV":
+			value = "production"
+		case value == "localhost" || strings.Contains(value, "://localhost"):
+			value = envfile.Placeholder(key)
+		}
+
+		if envfile.IsSecret(key) {
+			secrets = append(secrets, envVariable{Key: key, Value: value})
+		} else {
+			config = append(config, envVariable{Key: key, Value: value})
+		}
+	}
+	return config, secrets, nil
+}
+
 // createDockerMap initialize the dockerMap with the available dockers.
 func (p *ProjectConfig) createDockerMap() {
 	p.DockerMap = make(map[string]Docker)
@@ -427,10 +1123,26 @@ func (p *ProjectConfig) createDockerMap() {
 		dependencies: []string{},
 		templateGen:  docker.PostgresDockerTemplate{},
 	}
+	p.DockerMap["sqlserver"] = Docker{
+		dependencies: []string{},
+		templateGen:  docker.SqlServerDockerTemplate{},
+	}
+	p.DockerMap["cockroachdb"] = Docker{
+		dependencies: []string{},
+		templateGen:  docker.CockroachDBDockerTemplate{},
+	}
+	p.DockerMap["libsql"] = Docker{
+		dependencies: []string{},
+		templateGen:  docker.LibSQLDockerTemplate{},
+	}
 	p.DockerMap["mongo"] = Docker{
 		dependencies: []string{},
 		templateGen:  docker.MongoDockerTemplate{},
 	}
+	p.DockerMap["redis"] = Docker{
+		dependencies: []string{},
+		templateGen:  docker.RedisDockerTemplate{},
+	}
 }
 
 // createPath creates a new directory at the given path.
@@ -472,14 +1184,75 @@ func (p *ProjectConfig) createFileAndWriteTemplate(pathToCreate string, projectP
 	case "routesWithDB":
 		createdTemplate := template.Must(template.New(fileName).Parse(string(p.FrameworkMap[p.ProjectType].templateGen.RoutesWithDB())))
 		err = createdTemplate.Execute(createdFile, p)
+	case "httputil":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.FrameworkMap[p.ProjectType].templateGen.(HTTPUtilTemplateGenerator).HTTPUtil())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "health":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(tpl.HealthTemplate)))
+		err = createdTemplate.Execute(createdFile, p)
+	case "environment":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(web.EnvironmentTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "environmentTest":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(web.EnvironmentTestTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "config":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(tpl.ConfigTemplate)))
+		err = createdTemplate.Execute(createdFile, p)
+	case "configTest":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(tpl.ConfigTestTemplate)))
+		err = createdTemplate.Execute(createdFile, p)
 	case "database":
 		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DatabaseDriverMap[p.DatabaseDriver].templateGen.Service())))
 		err = createdTemplate.Execute(createdFile, p)
+	case "repository":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DataAccessMap[p.DataAccess].templateGen.Repository())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "entSchema":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DataAccessMap[p.DataAccess].templateGen.(EntTemplateGenerator).Schema())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "entGenerate":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DataAccessMap[p.DataAccess].templateGen.(EntTemplateGenerator).Generate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "migrate":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DatabaseDriverMap[p.DatabaseDriver].templateGen.(MigrationsTemplateGenerator).Migrate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "migrateCmd":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DatabaseDriverMap[p.DatabaseDriver].templateGen.(MigrationsTemplateGenerator).MigrateCmd())))
+		err = createdTemplate.Execute(createdFile, p)
 	case "docker-compose":
-		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DockerMap[p.Docker].templateGen.Docker())))
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.ComposeTemplate())))
+		if service, ok := p.DockerMap[p.DatabaseDriver]; ok {
+			template.Must(createdTemplate.Parse(string(service.templateGen.Docker())))
+		}
+		err = createdTemplate.Execute(createdFile, p)
+	case "docker-compose-override":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.ComposeOverrideTemplate())))
 		err = createdTemplate.Execute(createdFile, p)
+	case "devcontainer":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.DevContainerTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "devcontainer-compose":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.DevContainerComposeTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "dockerfile":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.DockerfileTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "dockerignore":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(docker.DockerignoreTemplate())))
+		err = createdTemplate.Execute(createdFile, p)
+	case "env-profile":
+		createdTemplate := template.Must(template.New(fileName).Parse(string(tpl.EnvProfileTemplate())))
+		err = createdTemplate.Execute(createdFile, envProfile{ProjectConfig: p, Profile: strings.TrimPrefix(fileName, ".env.")})
 	case "env-example":
-		createdTemplate := template.Must(template.New(fileName).Parse(string(p.DatabaseDriverMap[p.DatabaseDriver].templateGen.EnvExample())))
+		envBytes := [][]byte{tpl.EnvTemplate()}
+		if p.DatabaseDriver != "none" {
+			envBytes = append(envBytes, p.DatabaseDriverMap[p.DatabaseDriver].templateGen.EnvExample())
+		}
+		if p.HasFeature("cache") {
+			envBytes = append(envBytes, cache.EnvExample())
+		}
+		createdTemplate := template.Must(template.New(fileName).Parse(string(bytes.Join(envBytes, []byte("\n")))))
 		err = createdTemplate.Execute(createdFile, p)
 
 	case "env":
@@ -488,6 +1261,9 @@ func (p *ProjectConfig) createFileAndWriteTemplate(pathToCreate string, projectP
 				tpl.EnvTemplate(),
 				p.DatabaseDriverMap[p.DatabaseDriver].templateGen.Env(),
 			}
+			if p.HasFeature("cache") {
+				envBytes = append(envBytes, cache.Env())
+			}
 			createdTemplate := template.Must(template.New(fileName).Parse(string(bytes.Join(envBytes, []byte("\n")))))
 			err = createdTemplate.Execute(createdFile, p)
 		} else {
@@ -502,6 +1278,28 @@ func (p *ProjectConfig) createFileAndWriteTemplate(pathToCreate string, projectP
 	return nil
 }
 
+// goMinorVersion returns the minor version of a Go release version, or -1 if it cannot be parsed.
+func goMinorVersion(version string) int {
+	matches := goVersionRegexp.FindStringSubmatch(version)
+	if matches == nil {
+		return -1
+	}
+	minor, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return -1
+	}
+	return minor
+}
+
+// goToolchain returns the toolchain name of a Go release version, adding the patch
+// version when it is missing, e.g. 1.22 becomes go1.22.0.
+func goToolchain(version string) string {
+	if strings.Count(version, ".") == 1 {
+		version += ".0"
+	}
+	return "go" + version
+}
+
 // isValidWebFramework check if the input is supported or not
 func IsValidWebFramework(input string) bool {
 	for _, t := range SupportedWebframeworks {
@@ -512,6 +1310,302 @@ func IsValidWebFramework(input string) bool {
 	return false
 }
 
+// IsValidGoVersion checks if the input is a Go release version (e.g. 1.22 or 1.22.3) supported by the generated templates.
+func IsValidGoVersion(input string) bool {
+	if !goVersionRegexp.MatchString(input) {
+		return false
+	}
+	return goMinorVersion(input) >= minimumGoMinorVersion
+}
+
+// SupportsServeMuxPatterns reports whether the project Go version supports method and wildcard
+// patterns in http.ServeMux, which were introduced in Go 1.22.
+func (p *ProjectConfig) SupportsServeMuxPatterns() bool {
+	return supportsServeMuxPatterns(p.GoVersion)
+}
+
+// supportsServeMuxPatterns reports whether the Go version supports method and wildcard patterns in http.ServeMux.
+func supportsServeMuxPatterns(goVersion string) bool {
+	return goMinorVersion(goVersion) >= 22
+}
+
+// GoToolchain returns the toolchain name of the project Go version (e.g. go1.22.0).
+func (p *ProjectConfig) GoToolchain() string {
+	return goToolchain(p.GoVersion)
+}
+
+// DatabaseService returns the name of the docker-compose service of the database, empty for the databases
+// without a server.
+func (p *ProjectConfig) DatabaseService() string {
+	return databaseServices[p.DatabaseDriver].name
+}
+
+// DatabaseServicePort returns the port of the database service on the network of docker-compose.
+func (p *ProjectConfig) DatabaseServicePort() int {
+	return databaseServices[p.DatabaseDriver].port
+}
+
+// DatabaseImage returns the pinned image of the database server, empty for the databases without a server.
+func (p *ProjectConfig) DatabaseImage() string {
+	return databaseServices[p.DatabaseDriver].image
+}
+
+// CacheImage returns the pinned image of the Redis server of the cache feature.
+func (p *ProjectConfig) CacheImage() string {
+	return cacheImage
+}
+
+// ImageName returns the name of the Docker image of the project, the project name in the lower case letters,
+// digits and separators allowed by Docker.
+func (p *ProjectConfig) ImageName() string {
+	name := imageNameRegexp.ReplaceAllString(strings.ToLower(filepath.Base(p.ProjectName)), "-")
+	if name = strings.Trim(name, "-._"); name == "" {
+		return "app"
+	}
+	return name
+}
+
+// HasDocker reports whether the project gets a Dockerfile and docker-compose files.
+func (p *ProjectConfig) HasDocker() bool {
+	return p.docker() != "none"
+}
+
+// ContainerTool returns the command running the containers of the project, docker or podman.
+func (p *ProjectConfig) ContainerTool() string {
+	if p.docker() == "podman" {
+		return "podman"
+	}
+	return "docker"
+}
+
+// Image returns the reference of a public image. Podman is given the fully qualified reference, e.g.
+// docker.io/library/postgres for postgres, as it does not resolve short names to Docker Hub by default.
+func (p *ProjectConfig) Image(reference string) string {
+	if p.docker() != "podman" {
+		return reference
+	}
+	domain, _, found := strings.Cut(reference, "/")
+	switch {
+	case !found:
+		return "docker.io/library/" + reference
+	case strings.ContainsAny(domain, ".:") || domain == "localhost":
+		return reference
+	default:
+		return "docker.io/" + reference
+	}
+}
+
+// DevContainerImage returns the image of the dev container, for the Go release of go.mod. The go command downloads
+// the toolchain of go.mod when its patch release is newer than the one of the image.
+func (p *ProjectConfig) DevContainerImage() string {
+	return fmt.Sprintf("%s:1-1.%d-bookworm", devContainerImage, goMinorVersion(p.GoVersion))
+}
+
+// DevContainerExtensions returns the editor extensions recommended by the dev container: Go, the containers, the
+// clients of the database and the cache, and the Kubernetes tools of the deployment target.
+func (p *ProjectConfig) DevContainerExtensions() []string {
+	extensions := []string{"golang.go", "ms-azuretools.vscode-docker"}
+	extensions = append(extensions, databaseExtensions[p.DatabaseDriver]...)
+	if p.HasFeature("cache") && p.DatabaseDriver != "redis" {
+		extensions = append(extensions, databaseExtensions["redis"]...)
+	}
+	if p.Deploy == "k8s" || p.Deploy == "helm" {
+		extensions = append(extensions, "ms-kubernetes-tools.vscode-kubernetes-tools")
+	}
+	return extensions
+}
+
+// docker returns the container setup of the project, compose unless another one was chosen.
+func (p *ProjectConfig) docker() string {
+	if p.Docker == "" {
+		return "compose"
+	}
+	return p.Docker
+}
+
+// IsValidDeploy checks if the input is a supported deployment target.
+func IsValidDeploy(input string) bool {
+	return slices.Contains(SupportedDeploy, input)
+}
+
+// IsValidDevContainer checks if the input is a supported dev container setup.
+func IsValidDevContainer(input string) bool {
+	return slices.Contains(SupportedDevContainer, input)
+}
+
+// IsValidDocker checks if the input is a supported container setup.
+func IsValidDocker(input string) bool {
+	return slices.Contains(SupportedDocker, input)
+}
+
+// HasFeature reports whether the optional feature was selected for the project.
+func (p *ProjectConfig) HasFeature(feature string) bool {
+	return hasFeature(p.Features, feature)
+}
+
+// hasFeature reports whether the optional feature is one of the features.
+func hasFeature(features []string, feature string) bool {
+	return slices.Contains(features, feature)
+}
+
+// HasProfile reports whether the environment profile is scaffolded in the project.
+func (p *ProjectConfig) HasProfile(profile string) bool {
+	return slices.Contains(p.Profiles, profile)
+}
+
+// UsesORM reports whether the project accesses its SQL database through an ORM instead of raw database/sql.
+func (p *ProjectConfig) UsesORM() bool {
+	return usesORM(p.DataAccess)
+}
+
+// usesORM reports whether the data access style is an ORM instead of raw database/sql.
+func usesORM(dataAccess string) bool {
+	return dataAccess != "" && dataAccess != "raw"
+}
+
+// HasMigrate reports whether the database package has a Migrate function creating the schema,
+// generated by the migrations feature or by the ORM.
+func (p *ProjectConfig) HasMigrate() bool {
+	return p.HasFeature("migrations") || p.UsesORM()
+}
+
+// PureGoSQLite reports whether the project uses the pure Go SQLite backend instead of go-sqlite3.
+func (p *ProjectConfig) PureGoSQLite() bool {
+	return p.DatabaseDriver == "sqlite" && p.sqliteBackend() == "pure-go"
+}
+
+// CGOEnabled reports whether the project needs cgo to build, which is only the case of the cgo SQLite backend.
+// The other projects are built with CGO_ENABLED=0 into static binaries.
+func (p *ProjectConfig) CGOEnabled() bool {
+	return p.DatabaseDriver == "sqlite" && !p.PureGoSQLite()
+}
+
+// sqliteBackend returns the SQLite backend of the project, cgo unless another one was chosen.
+func (p *ProjectConfig) sqliteBackend() string {
+	if p.SQLiteBackend == "" {
+		return "cgo"
+	}
+	return p.SQLiteBackend
+}
+
+// IsValidSQLiteBackend checks if the input is a supported SQLite backend.
+func IsValidSQLiteBackend(input string) bool {
+	return slices.Contains(SupportedSQLiteBackends, input)
+}
+
+// IsValidProfile checks if the input is a supported environment profile.
+func IsValidProfile(input string) bool {
+	return slices.Contains(SupportedProfiles, input)
+}
+
+// ResolveProfiles returns the profiles in the order of SupportedProfiles without duplicates, or all the
+// supported profiles when none is given.
+func ResolveProfiles(profiles []string) []string {
+	if len(profiles) == 0 {
+		return slices.Clone(SupportedProfiles)
+	}
+	resolved := make([]string, 0, len(profiles))
+	for _, profile := range SupportedProfiles {
+		if slices.Contains(profiles, profile) {
+			resolved = append(resolved, profile)
+		}
+	}
+	return resolved
+}
+
+// k8sManifests is the data of the templates of the Kubernetes manifests.
+type k8sManifests struct {
+	*ProjectConfig
+	Config  []envVariable // the variables of the ConfigMap
+	Secrets []envVariable // the variables of the Secret
+	Overlay string        // the kustomize overlay, empty for the base
+	Profile string        // the environment profile of the overlay
+}
+
+// helmChart is the data of the templates of the Helm chart.
+type helmChart struct {
+	*ProjectConfig
+	Config  []envVariable // the values of the ConfigMap
+	Secrets []envVariable // the values of the Secret
+}
+
+// InClusterDatabase reports whether the chart can run the database server in the cluster. SQL Server is left
+// to a managed or existing server, its database cannot be created by a setting of the image.
+func (c helmChart) InClusterDatabase() bool {
+	return c.DatabaseService() != "" && c.DatabaseDriver != "sqlserver"
+}
+
+// GeneratedSecrets returns the passwords the chart generates when they are not set, except the one of the
+// insecure CockroachDB node, which has none.
+func (c helmChart) GeneratedSecrets() []string {
+	var generated []string
+	for _, secret := range c.Secrets {
+		if slices.Contains(envfile.SecretKeys, secret.Key) && !(c.DatabaseDriver == "cockroachdb" && secret.Key == "DB_PASSWORD") {
+			generated = append(generated, secret.Key)
+		}
+	}
+	return generated
+}
+
+// envVariable is a variable of a dotenv file.
+type envVariable struct {
+	Key   string
+	Value string
+}
+
+// envProfile is the data of the .env.<profile> template of an environment profile.
+type envProfile struct {
+	*ProjectConfig
+	Profile string
+}
+
+// IsValidFeature checks if the input is a supported optional feature.
+func IsValidFeature(input string) bool {
+	return slices.Contains(SupportedFeatures, input)
+}
+
+// IsFeatureSupported checks if the optional feature can be used with the database driver and data access style.
+func IsFeatureSupported(feature, databaseDriver, dataAccess string) bool {
+	return slices.Contains(featureDatabaseDrivers[feature], databaseDriver) && slices.Contains(featureDataAccess[feature], dataAccess)
+}
+
+// IsValidDataAccess checks if the input is a supported data access style.
+func IsValidDataAccess(input string) bool {
+	return slices.Contains(SupportedDataAccess, input)
+}
+
+// IsDataAccessSupported checks if the data access style can be used with the database driver,
+// the ORMs are only available for the SQL drivers.
+func IsDataAccessSupported(dataAccess, databaseDriver string) bool {
+	return dataAccess == "raw" || slices.Contains(dataAccessDatabaseDrivers, databaseDriver)
+}
+
+// ResolveFeatures returns the features together with the features they require, in the order of SupportedFeatures.
+func ResolveFeatures(features []string) []string {
+	selected := make(map[string]bool)
+	var add func(feature string)
+	add = func(feature string) {
+		if selected[feature] {
+			return
+		}
+		selected[feature] = true
+		for _, dependency := range featureDependencies[feature] {
+			add(dependency)
+		}
+	}
+	for _, feature := range features {
+		add(feature)
+	}
+
+	resolved := make([]string, 0, len(selected))
+	for _, feature := range SupportedFeatures {
+		if selected[feature] {
+			resolved = append(resolved, feature)
+		}
+	}
+	return resolved
+}
+
 // IsValidDatabaseDriver check if the input is supported or not
 func IsValidDatabaseDriver(input string) bool {
 	for _, t := range SupportedDatabaseDrivers {
@@ -1,10 +1,17 @@
 package project
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tz3/goforge/internal/envfile"
 )
 
 // func Test_ExitCLI(t *testing.T) test not necessary -> integration test only
@@ -90,7 +97,11 @@ func Test_IsValidDatabaseDriver(t *testing.T) {
 		{"mysql", true},
 		{"postgres", true},
 		{"sqlite", true},
+		{"sqlserver", true},
+		{"cockroachdb", true},
+		{"libsql", true},
 		{"mongo", true},
+		{"redis", true},
 		{"none", true},
 		{"unknown-driver", false},
 		{"", false},
@@ -106,3 +117,657 @@ func Test_IsValidDatabaseDriver(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsValidGoVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"1.21", true},
+		{"1.22", true},
+		{"1.22.3", true},
+		{"1.20", false}, // older than the minimum supported version
+		{"go1.22", false},
+		{"1.22rc1", false},
+		{"2.0", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := IsValidGoVersion(tt.input)
+			if result != tt.expected {
+				t.Errorf("IsValidGoVersion(%q) = %v; expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_GoToolchain(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.22", "go1.22.0"},
+		{"1.22.3", "go1.22.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p := &ProjectConfig{GoVersion: tt.input}
+			if result := p.GoToolchain(); result != tt.expected {
+				t.Errorf("GoToolchain() for %q = %q; expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_SupportsServeMuxPatterns(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"1.21", false},
+		{"1.21.9", false},
+		{"1.22", true},
+		{"1.23.1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p := &ProjectConfig{GoVersion: tt.input}
+			if result := p.SupportsServeMuxPatterns(); result != tt.expected {
+				t.Errorf("SupportsServeMuxPatterns() for %q = %v; expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_IsFeatureSupported(t *testing.T) {
+	tests := []struct {
+		feature        string
+		databaseDriver string
+		dataAccess     string
+		expected       bool
+	}{
+		{"migrations", "mysql", "raw", true},
+		{"migrations", "postgres", "raw", true},
+		{"migrations", "sqlite", "raw", true},
+		{"migrations", "mongo", "raw", true},
+		{"migrations", "none", "raw", false},
+		{"migrations", "postgres", "gorm", false},
+		{"sqlc", "postgres", "raw", true},
+		{"sqlc", "mongo", "raw", false},
+		{"sqlc", "mysql", "ent", false},
+		{"cache", "postgres", "gorm", true},
+		{"cache", "sqlite", "raw", true},
+		{"cache", "libsql", "raw", true},
+		{"cache", "redis", "raw", false},
+		{"cache", "mongo", "raw", false},
+		{"unknown-feature", "mysql", "raw", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.feature+"/"+tt.databaseDriver+"/"+tt.dataAccess, func(t *testing.T) {
+			result := IsFeatureSupported(tt.feature, tt.databaseDriver, tt.dataAccess)
+			if result != tt.expected {
+				t.Errorf("IsFeatureSupported(%q, %q, %q) = %v; expected %v", tt.feature, tt.databaseDriver, tt.dataAccess, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_IsDataAccessSupported(t *testing.T) {
+	tests := []struct {
+		dataAccess     string
+		databaseDriver string
+		expected       bool
+	}{
+		{"raw", "postgres", true},
+		{"raw", "mongo", true},
+		{"raw", "none", true},
+		{"gorm", "mysql", true},
+		{"gorm", "sqlite", true},
+		{"ent", "postgres", true},
+		{"gorm", "mongo", false},
+		{"ent", "none", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataAccess+"/"+tt.databaseDriver, func(t *testing.T) {
+			result := IsDataAccessSupported(tt.dataAccess, tt.databaseDriver)
+			if result != tt.expected {
+				t.Errorf("IsDataAccessSupported(%q, %q) = %v; expected %v", tt.dataAccess, tt.databaseDriver, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_CGOEnabled(t *testing.T) {
+	tests := []struct {
+		databaseDriver string
+		sqliteBackend  string
+		pureGo         bool
+		cgo            bool
+	}{
+		{"sqlite", "", false, true},
+		{"sqlite", "cgo", false, true},
+		{"sqlite", "pure-go", true, false},
+		{"libsql", "", false, false},
+		{"postgres", "", false, false},
+		{"none", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.databaseDriver+"/"+tt.sqliteBackend, func(t *testing.T) {
+			p := &ProjectConfig{DatabaseDriver: tt.databaseDriver, SQLiteBackend: tt.sqliteBackend}
+			if p.PureGoSQLite() != tt.pureGo || p.CGOEnabled() != tt.cgo {
+				t.Errorf("PureGoSQLite() = %v, CGOEnabled() = %v; expected %v, %v", p.PureGoSQLite(), p.CGOEnabled(), tt.pureGo, tt.cgo)
+			}
+		})
+	}
+}
+
+func Test_ImageName(t *testing.T) {
+	tests := []struct {
+		projectName string
+		expected    string
+	}{
+		{"my-project", "my-project"},
+		{"MyProject", "myproject"},
+		{"github.com/acme/order-service", "order-service"},
+		{"my project!", "my-project"},
+		{"__", "app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.projectName, func(t *testing.T) {
+			p := &ProjectConfig{ProjectName: tt.projectName}
+			if got := p.ImageName(); got != tt.expected {
+				t.Errorf("ImageName() = %q; expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_Image(t *testing.T) {
+	tests := []struct {
+		docker    string
+		reference string
+		expected  string
+		tool      string
+	}{
+		{"", "postgres:16.4-alpine", "postgres:16.4-alpine", "docker"},
+		{"compose", "cockroachdb/cockroach:v24.2.4", "cockroachdb/cockroach:v24.2.4", "docker"},
+		{"podman", "postgres:16.4-alpine", "docker.io/library/postgres:16.4-alpine", "podman"},
+		{"podman", "cockroachdb/cockroach:v24.2.4", "docker.io/cockroachdb/cockroach:v24.2.4", "podman"},
+		{"podman", "ghcr.io/tursodatabase/libsql-server:v0.24.32", "ghcr.io/tursodatabase/libsql-server:v0.24.32", "podman"},
+		{"podman", "localhost/app:dev", "localhost/app:dev", "podman"},
+		{"none", "golang", "golang", "docker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.docker+"/"+tt.reference, func(t *testing.T) {
+			p := &ProjectConfig{Docker: tt.docker}
+			if got := p.Image(tt.reference); got != tt.expected {
+				t.Errorf("Image(%q) = %q; expected %q", tt.reference, got, tt.expected)
+			}
+			if got := p.ContainerTool(); got != tt.tool {
+				t.Errorf("ContainerTool() = %q; expected %q", got, tt.tool)
+			}
+			if p.HasDocker() != (tt.docker != "none") {
+				t.Errorf("HasDocker() = %v with the %q setup", p.HasDocker(), tt.docker)
+			}
+		})
+	}
+}
+
+func Test_DevContainer(t *testing.T) {
+	tests := []struct {
+		databaseDriver string
+		features       []string
+		deploy         string
+		services       []string
+		extensions     []string
+	}{
+		{"postgres", []string{"cache"}, "helm", []string{"dev", "psql", "cache"},
+			[]string{"golang.go", "ms-azuretools.vscode-docker", "mtxr.sqltools", "mtxr.sqltools-driver-pg", "redis.redis-for-vscode", "ms-kubernetes-tools.vscode-kubernetes-tools"}},
+		{"sqlserver", nil, "none", []string{"dev", "mssql", "mssql-init"}, []string{"golang.go", "ms-azuretools.vscode-docker", "ms-mssql.mssql"}},
+		{"sqlite", nil, "none", []string{"dev"}, []string{"golang.go", "ms-azuretools.vscode-docker", "mtxr.sqltools", "mtxr.sqltools-driver-sqlite"}},
+		{"none", nil, "k8s", []string{"dev"}, []string{"golang.go", "ms-azuretools.vscode-docker", "ms-kubernetes-tools.vscode-kubernetes-tools"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.databaseDriver, func(t *testing.T) {
+			p := &ProjectConfig{DatabaseDriver: tt.databaseDriver, GoVersion: "1.22.3", Features: tt.features, Deploy: tt.deploy, DevContainer: "compose"}
+			projectPath := writeTestProject(t, p, testFile{root, "docker-compose.yml", "docker-compose"},
+				testFile{devContainerPath, "devcontainer.json", "devcontainer"}, testFile{devContainerPath, "docker-compose.yml", "devcontainer-compose"})
+			if got := p.DevContainerImage(); got != "mcr.microsoft.com/devcontainers/go:1-1.22-bookworm" {
+				t.Errorf("DevContainerImage() = %q; expected the image of Go 1.22", got)
+			}
+			if got := p.DevContainerExtensions(); !slices.Equal(got, tt.extensions) {
+				t.Errorf("DevContainerExtensions() = %q; expected %q", got, tt.extensions)
+			}
+
+			// devcontainer.json is JSON with comments, which are only written on their own lines.
+			content, err := os.ReadFile(filepath.Join(projectPath, devContainerPath, "devcontainer.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var lines []string
+			for _, line := range strings.Split(string(content), "\n") {
+				if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+					lines = append(lines, line)
+				}
+			}
+			var devContainer struct {
+				DockerComposeFile []string
+				Service           string
+				RunServices       []string
+				PostCreateCommand string
+				Customizations    struct {
+					VSCode struct{ Extensions []string } `json:"vscode"`
+				}
+			}
+			if err := json.Unmarshal([]byte(strings.Join(lines, "\n")), &devContainer); err != nil {
+				t.Fatalf("devcontainer.json is not valid JSON: %v\n%s", err, content)
+			}
+			if !slices.Equal(devContainer.RunServices, tt.services) || !slices.Equal(devContainer.Customizations.VSCode.Extensions, tt.extensions) ||
+				!strings.Contains(devContainer.PostCreateCommand, "go mod download") || !strings.Contains(devContainer.PostCreateCommand, "air") {
+				t.Errorf("devcontainer.json = %+v; expected the services %q, the extensions %q and the installation of air", devContainer, tt.services, tt.extensions)
+			}
+
+			// The services run by the dev container are defined by the merged docker-compose files.
+			services := make(map[string]bool)
+			for _, file := range devContainer.DockerComposeFile {
+				content, err := os.ReadFile(filepath.Join(projectPath, devContainerPath, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				var compose struct{ Services map[string]any }
+				if err := yaml.Unmarshal(content, &compose); err != nil {
+					t.Fatalf("%s is not valid YAML: %v\n%s", file, err, content)
+				}
+				for service := range compose.Services {
+					services[service] = true
+				}
+			}
+			for _, service := range devContainer.RunServices {
+				if !services[service] {
+					t.Errorf("service %s of the dev container is not defined by %q", service, devContainer.DockerComposeFile)
+				}
+			}
+		})
+	}
+}
+
+func Test_ResolveFeatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"no features", nil, []string{}},
+		{"feature without dependencies", []string{"migrations"}, []string{"migrations"}},
+		{"dependencies added", []string{"sqlc"}, []string{"migrations", "sqlc"}},
+		{"duplicates removed", []string{"sqlc", "migrations", "sqlc"}, []string{"migrations", "sqlc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ResolveFeatures(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("ResolveFeatures(%q) = %q; expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_ResolveProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"no profiles", nil, []string{"local", "test", "staging", "production"}},
+		{"ordered", []string{"production", "local"}, []string{"local", "production"}},
+		{"duplicates removed", []string{"test", "test"}, []string{"test"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ResolveProfiles(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("ResolveProfiles(%q) = %q; expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_Manifest(t *testing.T) {
+	tempDir := t.TempDir()
+	if _, err := ReadManifest(tempDir); err == nil {
+		t.Errorf("ReadManifest() without %s should fail", ManifestFile)
+	}
+
+	p := &ProjectConfig{
+		ProjectName:    "github.com/acme/shop",
+		ProjectType:    "chi",
+		DatabaseDriver: "postgres",
+		DataAccess:     "gorm",
+		Features:       []string{"migrations"},
+		Profiles:       []string{"local", "production"},
+		Docker:         "podman",
+		DevContainer:   "compose",
+		GoVersion:      "1.22",
+	}
+	if err := WriteManifest(tempDir, p.Manifest()); err != nil {
+		t.Fatalf("WriteManifest() error = %v", err)
+	}
+	manifest, err := ReadManifest(tempDir)
+	if err != nil {
+		t.Fatalf("ReadManifest() error = %v", err)
+	}
+	if manifest.Module != p.ProjectName || manifest.Framework != p.ProjectType || manifest.DatabaseDriver != p.DatabaseDriver ||
+		manifest.DataAccess != p.DataAccess || !slices.Equal(manifest.Features, p.Features) ||
+		!slices.Equal(manifest.Profiles, p.Profiles) || manifest.Docker != p.Docker || manifest.DevContainer != p.DevContainer || manifest.GoVersion != p.GoVersion {
+		t.Errorf("ReadManifest() = %+v; expected the manifest of %+v", manifest, p)
+	}
+	if !manifest.HasFeature("migrations") || !manifest.UsesORM() || !manifest.SupportsServeMuxPatterns() {
+		t.Errorf("manifest %+v does not report the choices of the project", manifest)
+	}
+}
+
+func Test_createK8s(t *testing.T) {
+	tests := []struct {
+		databaseDriver string
+		features       []string
+		files          []string
+		secrets        []string
+	}{
+		{"postgres", []string{"cache"}, []string{"configmap.yaml", "deployment.yaml", "kustomization.yaml", "secret.yaml", "service.yaml"}, []string{"DB_PASSWORD", "CACHE_PASSWORD"}},
+		{"sqlite", nil, []string{"configmap.yaml", "deployment.yaml", "kustomization.yaml", "pvc.yaml", "service.yaml"}, nil},
+		{"none", nil, []string{"configmap.yaml", "deployment.yaml", "kustomization.yaml", "service.yaml"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.databaseDriver, func(t *testing.T) {
+			p := &ProjectConfig{DatabaseDriver: tt.databaseDriver, DataAccess: "raw", Features: tt.features, Profiles: SupportedProfiles, Deploy: "k8s"}
+			projectPath := writeTestProject(t, p, testFile{internalConfigPath, "config.go", "config"}, testFile{root, ".env.example", "env-example"})
+
+			if err := p.createK8s(projectPath); err != nil {
+				t.Fatalf("createK8s() error = %v", err)
+			}
+
+			base, err := os.ReadDir(filepath.Join(projectPath, k8sBasePath))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var files []string
+			for _, entry := range base {
+				files = append(files, entry.Name())
+			}
+			if !slices.Equal(files, tt.files) {
+				t.Errorf("files of %s = %q; expected %q", k8sBasePath, files, tt.files)
+			}
+
+			// Every manifest is valid YAML, and the base has the resources listed by its kustomization.
+			manifests := make(map[string]map[string]any)
+			err = filepath.WalkDir(filepath.Join(projectPath, "deploy"), func(path string, entry os.DirEntry, err error) error {
+				if err != nil || entry.IsDir() {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				var manifest map[string]any
+				if err := yaml.Unmarshal(content, &manifest); err != nil {
+					return fmt.Errorf("%s is not valid YAML: %v\n%s", path, err, content)
+				}
+				relative, _ := filepath.Rel(projectPath, path)
+				manifests[relative] = manifest
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, resource := range manifests[k8sBasePath+"/kustomization.yaml"]["resources"].([]any) {
+				if _, ok := manifests[k8sBasePath+"/"+resource.(string)]; !ok {
+					t.Errorf("resource %s of the kustomization is missing", resource)
+				}
+			}
+			for overlay := range k8sOverlays {
+				if _, ok := manifests[k8sOverlaysPath+"/"+overlay+"/kustomization.yaml"]; !ok {
+					t.Errorf("overlay %s is missing", overlay)
+				}
+			}
+
+			var deployment struct {
+				Spec struct {
+					Template struct {
+						Spec struct {
+							Containers []struct {
+								LivenessProbe struct {
+									HTTPGet struct{ Path string } `yaml:"httpGet"`
+								} `yaml:"livenessProbe"`
+								ReadinessProbe struct {
+									HTTPGet struct{ Path string } `yaml:"httpGet"`
+								} `yaml:"readinessProbe"`
+								Resources struct{ Requests map[string]string }
+							}
+						}
+					}
+				}
+			}
+			content, err := os.ReadFile(filepath.Join(projectPath, k8sBasePath, "deployment.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := yaml.Unmarshal(content, &deployment); err != nil {
+				t.Fatal(err)
+			}
+			container := deployment.Spec.Template.Spec.Containers[0]
+			if container.LivenessProbe.HTTPGet.Path != "/livez" || container.ReadinessProbe.HTTPGet.Path != "/readyz" || container.Resources.Requests["memory"] == "" {
+				t.Errorf("container of the deployment = %+v; expected the /livez and /readyz probes and resource requests", container)
+			}
+
+			// The ConfigMap and the Secret have the variables of .env.example read by the config package.
+			example, err := envfile.Read(filepath.Join(projectPath, envfile.ExampleFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			configKeys, err := envfile.ConfigKeys(filepath.Join(projectPath, envfile.ConfigFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var secrets []string
+			for _, key := range example.Keys() {
+				if !slices.Contains(configKeys, key) {
+					continue
+				}
+				data, secret := manifests[k8sBasePath+"/configmap.yaml"]["data"].(map[string]any), false
+				if _, ok := data[key]; !ok {
+					if data, secret = manifests[k8sBasePath+"/secret.yaml"]["stringData"].(map[string]any), true; data[key] == nil {
+						t.Errorf("%s is in neither the ConfigMap nor the Secret", key)
+					}
+				}
+				if value, _ := data[key].(string); strings.Contains(value, "localhost") {
+					t.Errorf("%s = %q; expected the local address to be replaced", key, value)
+				}
+				if secret {
+					secrets = append(secrets, key)
+				}
+			}
+			if !slices.Equal(secrets, tt.secrets) {
+				t.Errorf("secrets = %q; expected %q", secrets, tt.secrets)
+			}
+		})
+	}
+}
+
+func Test_createHelm(t *testing.T) {
+	tests := []struct {
+		databaseDriver string
+		features       []string
+		templates      []string
+		database       bool
+	}{
+		{"postgres", []string{"cache"}, []string{"NOTES.txt", "_helpers.tpl", "cache.yaml", "configmap.yaml", "database.yaml", "deployment.yaml", "hpa.yaml", "ingress.yaml", "secret.yaml", "service.yaml"}, true},
+		{"sqlite", nil, []string{"NOTES.txt", "_helpers.tpl", "configmap.yaml", "deployment.yaml", "ingress.yaml", "pvc.yaml", "service.yaml"}, false},
+		{"sqlserver", nil, []string{"NOTES.txt", "_helpers.tpl", "configmap.yaml", "deployment.yaml", "hpa.yaml", "ingress.yaml", "secret.yaml", "service.yaml"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.databaseDriver, func(t *testing.T) {
+			p := &ProjectConfig{DatabaseDriver: tt.databaseDriver, DataAccess: "raw", Features: tt.features, Profiles: SupportedProfiles, Deploy: "helm"}
+			projectPath := writeTestProject(t, p, testFile{internalConfigPath, "config.go", "config"}, testFile{root, ".env", "env"},
+				testFile{root, ".env.example", "env-example"})
+
+			if err := p.createHelm(projectPath); err != nil {
+				t.Fatalf("createHelm() error = %v", err)
+			}
+
+			chartPath := filepath.Join(projectPath, helmChartsPath, "order-service")
+			entries, err := os.ReadDir(filepath.Join(chartPath, "templates"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var templates []string
+			for _, entry := range entries {
+				templates = append(templates, entry.Name())
+			}
+			if !slices.Equal(templates, tt.templates) {
+				t.Errorf("templates of the chart = %q; expected %q", templates, tt.templates)
+			}
+
+			// The goforge actions are all executed, leaving the Helm ones.
+			err = filepath.WalkDir(chartPath, func(path string, entry os.DirEntry, err error) error {
+				if err != nil || entry.IsDir() {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if strings.Contains(string(content), "[[") || strings.Contains(string(content), "]]") {
+					t.Errorf("%s has a goforge action left:\n%s", path, content)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var chart struct {
+				APIVersion string `yaml:"apiVersion"`
+				Name       string
+				Version    string
+			}
+			var values struct {
+				Config   map[string]string
+				Secrets  map[string]string
+				Database *struct {
+					Enabled bool
+					Image   string
+				}
+			}
+			for file, out := range map[string]any{"Chart.yaml": &chart, "values.yaml": &values} {
+				content, err := os.ReadFile(filepath.Join(chartPath, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := yaml.Unmarshal(content, out); err != nil {
+					t.Fatalf("%s is not valid YAML: %v\n%s", file, err, content)
+				}
+			}
+			if chart.APIVersion != "v2" || chart.Name != "order-service" || chart.Version == "" {
+				t.Errorf("Chart.yaml = %+v; expected a v2 chart named order-service", chart)
+			}
+			if (values.Database != nil && values.Database.Enabled && values.Database.Image == p.DatabaseImage()) != tt.database {
+				t.Errorf("database values = %+v; expected the database to be run by the chart: %t", values.Database, tt.database)
+			}
+
+			// The values have the variables of .env.example read by the config package, without the local addresses.
+			example, err := envfile.Read(filepath.Join(projectPath, envfile.ExampleFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			configKeys, err := envfile.ConfigKeys(filepath.Join(projectPath, envfile.ConfigFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range example.Keys() {
+				if !slices.Contains(configKeys, key) {
+					continue
+				}
+				if envfile.IsSecret(key) {
+					if value, ok := values.Secrets[key]; !ok || value != "" {
+						t.Errorf("secret %s = %q, set: %t; expected an empty secret", key, value, ok)
+					}
+					continue
+				}
+				value, ok := values.Config[key]
+				if !ok {
+					t.Errorf("%s is not in the config values", key)
+				}
+				if strings.Contains(value, "localhost") {
+					t.Errorf("%s = %q; expected the local address to be replaced", key, value)
+				}
+			}
+		})
+	}
+}
+
+func Test_CreateMainFile_Ent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("creates projects with their dependencies")
+	}
+	goVersion, err := LocalGoVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ent generates its client with the local Go toolchain, whatever the other dependencies of the driver.
+	for _, driver := range dataAccessDatabaseDrivers {
+		t.Run(driver, func(t *testing.T) {
+			p := &ProjectConfig{ProjectName: "orderservice", AbsolutePath: t.TempDir(), ProjectType: "standard-library", DatabaseDriver: driver,
+				DataAccess: "ent", SQLiteBackend: "cgo", GoVersion: goVersion, Profiles: []string{"local"}, Docker: "none", Deploy: "none",
+				DevContainer: "none", FrameworkMap: make(map[string]WebFramework), DatabaseDriverMap: make(map[string]DatabaseDriver)}
+			if err := p.CreateMainFile(); err != nil {
+				t.Fatalf("CreateMainFile() error = %v", err)
+			}
+
+			projectPath := filepath.Join(p.AbsolutePath, p.ProjectName)
+			if _, err := os.Stat(filepath.Join(projectPath, entPath, "client.go")); err != nil {
+				t.Errorf("the ent client is not generated: %v", err)
+			}
+			if err := executeCmd("go", []string{"vet", "./..."}, projectPath); err != nil {
+				t.Errorf("go vet ./... error = %v", err)
+			}
+		})
+	}
+}
+
+// testFile is a file of a test project, written by the method of createFileAndWriteTemplate.
+type testFile struct{ path, name, method string }
+
+// writeTestProject writes the files of the github.com/acme/order-service project of the config in a temporary
+// directory and returns its path.
+func writeTestProject(t *testing.T, p *ProjectConfig, files ...testFile) string {
+	t.Helper()
+	projectPath := t.TempDir()
+	p.ProjectName = "github.com/acme/order-service"
+	p.DatabaseDriverMap = make(map[string]DatabaseDriver)
+	p.createDatabaseDriverMap()
+	p.createDockerMap()
+	for _, file := range files {
+		if err := p.createPath(file.path, projectPath); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.createFileAndWriteTemplate(file.path, projectPath, file.name, file.method); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return projectPath
+}
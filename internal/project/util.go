@@ -3,7 +3,11 @@ package project
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"os/exec"
+	"slices"
+	"strings"
 )
 
 // executeCmd runs a command with given arguments in a specified directory.
@@ -19,6 +23,19 @@ func executeCmd(name string, args []string, dir string) error {
 	return nil
 }
 
+// executeCmdOutput runs a command with given arguments in a specified directory and returns its standard output.
+// It returns an error if the command execution fails.
+func executeCmdOutput(name string, args []string, dir string) (string, error) {
+	command := exec.Command(name, args...)
+	command.Dir = dir
+	var out bytes.Buffer
+	command.Stdout = &out
+	if err := command.Run(); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
 // initGoMod initializes a new Go module in the specified directory.
 // It returns an error if the module initialization fails.
 func initGoMod(projectName string, appDir string) error {
@@ -39,16 +56,50 @@ func initGitRepo(projectPath string) error {
 	return nil
 }
 
-// goGetDependencies fetches the specified Go packages/dependencies and updates it.
-// It returns an error if the package fetching fails.
-func goGetDependencies(appDir string, packages []string) error {
+// goGetDependencies fetches the specified Go packages/dependencies at their newest versions supporting the Go
+// version of the project, which go get would otherwise raise to the one of the newest dependencies.
+// It returns an error if the package fetching fails or if a package has no version supporting the Go version.
+func goGetDependencies(appDir string, goVersion string, packages []string) error {
+	if len(packages) == 0 {
+		return nil
+	}
 	for _, packageName := range packages {
 		if err := executeCmd("go",
-			[]string{"get", "-u", packageName},
+			[]string{"get", packageName},
 			appDir); err != nil {
 			return err
 		}
 	}
+
+	err := pinGoVersion(appDir, goVersion)
+	if err != nil {
+		return err
+	}
+
+	// go get drops the requirements without a version supporting the Go version
+	requirements, err := goModRequirements(appDir)
+	if err != nil {
+		return err
+	}
+	for _, packageName := range packages {
+		path, _, _ := strings.Cut(packageName, "@")
+		if !slices.ContainsFunc(requirements, func(module string) bool {
+			return path == module || strings.HasPrefix(path, module+"/")
+		}) {
+			return fmt.Errorf("no version of %s supports Go %s", path, goVersion)
+		}
+	}
+	return nil
+}
+
+// EntGenerate runs the ent code generator on the schemas of the project.
+// It returns an error if the code generation fails.
+func EntGenerate(appDir string) error {
+	if err := executeCmd("go",
+		[]string{"run", "-mod=mod", "entgo.io/ent/cmd/ent", "generate", "./internal/ent/schema"},
+		appDir); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -73,3 +124,87 @@ func goTidy(appDir string) error {
 	}
 	return nil
 }
+
+// setGoVersion sets the go and toolchain directives of the go.mod file in the appDir directory.
+// It returns an error if the go.mod file cannot be edited.
+func setGoVersion(appDir string, version string) error {
+	err := executeCmd("go",
+		[]string{"mod", "edit", fmt.Sprintf("-go=%s", version), fmt.Sprintf("-toolchain=%s", goToolchain(version))},
+		appDir)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// pinGoVersion downgrades the dependencies requiring a newer Go version than the version of the project to their
+// newest versions supporting it, which sets the go directive of the go.mod file in appDir to the release of the
+// version, e.g. 1.22.0 for 1.22.
+// It returns an error if the dependencies cannot be downgraded.
+func pinGoVersion(appDir string, version string) error {
+	modGoVersion, err := goModGoVersion(appDir)
+	if err != nil {
+		return err
+	}
+	if goToolchain(modGoVersion) == goToolchain(version) {
+		return nil
+	}
+
+	err = executeCmd("go",
+		[]string{"get", "go@" + strings.TrimPrefix(goToolchain(version), "go"), "toolchain@none"},
+		appDir)
+	if err != nil {
+		return fmt.Errorf("could not downgrade the dependencies requiring Go %s to Go %s: %w", modGoVersion, version, err)
+	}
+	return nil
+}
+
+// goModGoVersion returns the version of the go directive of the go.mod file in the appDir directory.
+func goModGoVersion(appDir string) (string, error) {
+	out, err := executeCmdOutput("go", []string{"mod", "edit", "-json"}, appDir)
+	if err != nil {
+		return "", err
+	}
+	var goMod struct {
+		Go string
+	}
+	if err := json.Unmarshal([]byte(out), &goMod); err != nil {
+		return "", err
+	}
+	return goMod.Go, nil
+}
+
+// goModRequirements returns the paths of the modules required by the go.mod file in the appDir directory.
+func goModRequirements(appDir string) ([]string, error) {
+	out, err := executeCmdOutput("go", []string{"mod", "edit", "-json"}, appDir)
+	if err != nil {
+		return nil, err
+	}
+	var goMod struct {
+		Require []struct {
+			Path string
+		}
+	}
+	if err := json.Unmarshal([]byte(out), &goMod); err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, requirement := range goMod.Require {
+		paths = append(paths, requirement.Path)
+	}
+	return paths, nil
+}
+
+// LocalGoVersion returns the release version (e.g. 1.22.3) of the locally installed Go toolchain.
+// Pre-release toolchains such as go1.23rc1 are reported by their major and minor version.
+func LocalGoVersion() (string, error) {
+	out, err := executeCmdOutput("go", []string{"env", "GOVERSION"}, "")
+	if err != nil {
+		return "", err
+	}
+	version := strings.TrimPrefix(strings.TrimSpace(out), "go")
+	if match := localGoVersionRegexp.FindString(version); match != "" {
+		return match, nil
+	}
+	return "", fmt.Errorf("could not parse the local Go version: %s", out)
+}
@@ -79,10 +79,26 @@ func InitSteps() *Steps {
 						Title: "sqlite",
 						Desc:  "Use go-sqlite3, SQLite driver for go that using database/sql from: https://github.com/mattn/go-sqlite3",
 					},
+					{
+						Title: "sqlserver",
+						Desc:  "Use go-mssqldb, the Microsoft SQL Server driver for go from: https://github.com/microsoft/go-mssqldb",
+					},
+					{
+						Title: "cockroachdb",
+						Desc:  "Use pgx with the transaction retry helpers of cockroach-go for CockroachDB from: https://github.com/cockroachdb/cockroach-go",
+					},
+					{
+						Title: "libsql",
+						Desc:  "Use libsql-client-go, the pure Go client of libSQL servers and Turso from: https://github.com/tursodatabase/libsql-client-go",
+					},
 					{
 						Title: "mongo",
 						Desc:  "Use mongo-driver, the Go driver for MongoDB from: https://github.com/mongodb/mongo-go-driver",
 					},
+					{
+						Title: "redis",
+						Desc:  "Use go-redis, the Redis client for go from: https://github.com/redis/go-redis",
+					},
 					{
 						Title: "none",
 						Desc:  "Project with no Database setup!",
@@ -90,6 +106,128 @@ func InitSteps() *Steps {
 				},
 				Headers: "What database driver do you want to use in your Go project?",
 			},
+			"data-access": {
+				StepName: "Data Access",
+				Options: []Option{
+					{
+						Title: "raw",
+						Desc:  "Plain SQL with database/sql, the standard golang library",
+					},
+					{
+						Title: "gorm",
+						Desc:  "Use GORM, the ORM library for Golang from: https://github.com/go-gorm/gorm",
+					},
+					{
+						Title: "ent",
+						Desc:  "Use ent, the entity framework generating the code from the schema from: https://github.com/ent/ent",
+					},
+				},
+				Headers: "How do you want to access the database in your Go project?",
+			},
+			"sqlite-backend": {
+				StepName: "SQLite Backend",
+				Options: []Option{
+					{
+						Title: "cgo",
+						Desc:  "Use go-sqlite3, the cgo binding of the SQLite C library from: https://github.com/mattn/go-sqlite3",
+					},
+					{
+						Title: "pure-go",
+						Desc:  "Use the pure Go translation of SQLite for static CGO_ENABLED=0 builds from: https://gitlab.com/cznic/sqlite",
+					},
+				},
+				Headers: "Which SQLite backend do you want to use in your Go project?",
+			},
+			"features": {
+				StepName: "Features",
+				Options: []Option{
+					{
+						Title: "migrations",
+						Desc:  "SQL migrations with an embedded runner and cmd/migrate, or the collection indexes for MongoDB",
+					},
+					{
+						Title: "sqlc",
+						Desc:  "Type-safe Go code for hand-written SQL queries, generated with sqlc from: https://github.com/sqlc-dev/sqlc",
+					},
+					{
+						Title: "cache",
+						Desc:  "A cache interface with a Redis implementation from: https://github.com/redis/go-redis and an in-memory one for the tests",
+					},
+				},
+				Headers: "Which optional features do you want to add to your Go project?",
+			},
+			"profiles": {
+				StepName: "Profiles",
+				Options: []Option{
+					{
+						Title: "local",
+						Desc:  "Development on your machine: debug logs in text, any CORS origin and the pprof endpoints",
+					},
+					{
+						Title: "test",
+						Desc:  "Automated tests: info logs in text and any CORS origin",
+					},
+					{
+						Title: "staging",
+						Desc:  "Pre-production deployment: JSON logs and only the CORS origins you allow",
+					},
+					{
+						Title: "production",
+						Desc:  "Production deployment: JSON logs and only the CORS origins you allow",
+					},
+				},
+				Headers: "Which environment profiles do you want to scaffold? Selecting none scaffolds all of them",
+			},
+			"docker": {
+				StepName: "Docker",
+				Options: []Option{
+					{
+						Title: "compose",
+						Desc:  "A multi-stage Dockerfile and docker-compose files running the application with its services on Docker",
+					},
+					{
+						Title: "podman",
+						Desc:  "The same files for rootless Podman: fully qualified images, SELinux labels and podman Makefile targets",
+					},
+					{
+						Title: "none",
+						Desc:  "No container file, the application and its services run on your machine",
+					},
+				},
+				Headers: "How do you want to run your Go project in containers?",
+			},
+			"deploy": {
+				StepName: "Deploy",
+				Options: []Option{
+					{
+						Title: "none",
+						Desc:  "No deployment file",
+					},
+					{
+						Title: "k8s",
+						Desc:  "Kubernetes manifests in deploy/k8s with a kustomize base and dev and prod overlays",
+					},
+					{
+						Title: "helm",
+						Desc:  "Helm chart in charts/<project> with its values derived from the config of the project",
+					},
+				},
+				Headers: "Where do you want to deploy your Go project?",
+			},
+			"devcontainer": {
+				StepName: "Dev Container",
+				Options: []Option{
+					{
+						Title: "none",
+						Desc:  "No dev container",
+					},
+					{
+						Title: "compose",
+						Desc:  "Dev container with Go, air and the editor extensions, run next to the services of docker-compose.yml",
+					},
+				},
+				Headers: "Do you want a dev container for your Go project?",
+			},
 		},
 	}
 
@@ -0,0 +1,45 @@
+// Package cache provides the templates of the cache feature: the Cache interface of the generated
+// project with its Redis implementation and the in-memory implementation used by the tests.
+package cache
+
+import (
+	_ "embed"
+)
+
+//go:embed static/cache.go.tmpl
+var cacheTemplate []byte
+
+//go:embed static/redis.go.tmpl
+var redisTemplate []byte
+
+//go:embed static/memory.go.tmpl
+var memoryTemplate []byte
+
+//go:embed static/memory_test.go.tmpl
+var memoryTestTemplate []byte
+
+//go:embed static/env.tmpl
+var envTemplate []byte
+
+//go:embed static/env_example.tmpl
+var envExampleTemplate []byte
+
+// Files returns the files of the cache package, keyed by file name.
+func Files() map[string][]byte {
+	return map[string][]byte{
+		"cache.go":       cacheTemplate,
+		"redis.go":       redisTemplate,
+		"memory.go":      memoryTemplate,
+		"memory_test.go": memoryTestTemplate,
+	}
+}
+
+// Env returns the variables of the Redis cache for the .env file.
+func Env() []byte {
+	return envTemplate
+}
+
+// EnvExample returns the variables of the Redis cache for the .env.example file.
+func EnvExample() []byte {
+	return envExampleTemplate
+}
@@ -0,0 +1,10 @@
+// Package template provides a set of templates for the main function, HTTP server, README, and Makefile.
+package template
+
+import _ "embed"
+
+//go:embed static/config.go.tmpl
+var ConfigTemplate []byte
+
+//go:embed static/config_test.go.tmpl
+var ConfigTestTemplate []byte
@@ -0,0 +1,28 @@
+package db
+
+import (
+	_ "embed"
+)
+
+type CockroachDBTemplate struct{}
+
+//go:embed static/service/cockroachdb.go.tmpl
+var cockroachdbServiceTemplate []byte
+
+//go:embed static/env/example/cockroachdb.tmpl
+var cockroachdbEnvExampleTemplate []byte
+
+//go:embed static/env/cockroachdb.tmpl
+var cockroachdbEnvTemplate []byte
+
+func (m CockroachDBTemplate) Service() []byte {
+	return cockroachdbServiceTemplate
+}
+
+func (m CockroachDBTemplate) Env() []byte {
+	return cockroachdbEnvTemplate
+}
+
+func (m CockroachDBTemplate) EnvExample() []byte {
+	return cockroachdbEnvExampleTemplate
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	_ "embed"
+)
+
+type LibSQLTemplate struct{}
+
+//go:embed static/service/libsql.go.tmpl
+var libsqlServiceTemplate []byte
+
+//go:embed static/env/example/libsql.tmpl
+var libsqlEnvExampleTemplate []byte
+
+//go:embed static/env/libsql.tmpl
+var libsqlEnvTemplate []byte
+
+func (m LibSQLTemplate) Service() []byte {
+	return libsqlServiceTemplate
+}
+
+func (m LibSQLTemplate) Env() []byte {
+	return libsqlEnvTemplate
+}
+
+func (m LibSQLTemplate) EnvExample() []byte {
+	return libsqlEnvExampleTemplate
+}
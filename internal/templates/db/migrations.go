@@ -0,0 +1,23 @@
+package db
+
+import (
+	_ "embed"
+)
+
+//go:embed static/migrate/sql.go.tmpl
+var sqlMigrateTemplate []byte
+
+//go:embed static/migrate/cmd/sql.go.tmpl
+var sqlMigrateCmdTemplate []byte
+
+//go:embed static/migrate/mongo.go.tmpl
+var mongoMigrateTemplate []byte
+
+//go:embed static/migrate/cmd/mongo.go.tmpl
+var mongoMigrateCmdTemplate []byte
+
+// Initial migration file names, the runner sorts the migrations by the version prefix.
+const (
+	initialUpMigration   = "0001_create_users.up.sql"
+	initialDownMigration = "0001_create_users.down.sql"
+)
@@ -26,3 +26,16 @@ func (m MongoTemplate) Env() []byte {
 func (m MongoTemplate) EnvExample() []byte {
 	return mongoEnvExampleTemplate
 }
+
+func (m MongoTemplate) Migrate() []byte {
+	return mongoMigrateTemplate
+}
+
+func (m MongoTemplate) MigrateCmd() []byte {
+	return mongoMigrateCmdTemplate
+}
+
+// Migrations returns no migration files, the collections of MongoDB have no schema to migrate.
+func (m MongoTemplate) Migrations() map[string][]byte {
+	return map[string][]byte{}
+}
@@ -15,6 +15,21 @@ var mysqlEnvExampleTemplate []byte
 //go:embed static/env/mysql.tmpl
 var mysqlEnvTemplate []byte
 
+//go:embed static/migrations/mysql/0001_create_users.up.sql
+var mysqlInitialUpMigration []byte
+
+//go:embed static/migrations/mysql/0001_create_users.down.sql
+var mysqlInitialDownMigration []byte
+
+//go:embed static/sqlc/mysql/sqlc.yaml
+var mysqlSQLCConfig []byte
+
+//go:embed static/sqlc/mysql/users.sql
+var mysqlQueries []byte
+
+//go:embed static/sqlc/mysql/users.sql.go.tmpl
+var mysqlQueriesCode []byte
+
 func (m MysqlTemplate) Service() []byte {
 	return mysqlServiceTemplate
 }
@@ -26,3 +41,30 @@ func (m MysqlTemplate) Env() []byte {
 func (m MysqlTemplate) EnvExample() []byte {
 	return mysqlEnvExampleTemplate
 }
+
+func (m MysqlTemplate) Migrate() []byte {
+	return sqlMigrateTemplate
+}
+
+func (m MysqlTemplate) MigrateCmd() []byte {
+	return sqlMigrateCmdTemplate
+}
+
+func (m MysqlTemplate) Migrations() map[string][]byte {
+	return map[string][]byte{
+		initialUpMigration:   mysqlInitialUpMigration,
+		initialDownMigration: mysqlInitialDownMigration,
+	}
+}
+
+func (m MysqlTemplate) SQLCConfig() []byte {
+	return mysqlSQLCConfig
+}
+
+func (m MysqlTemplate) Queries() map[string][]byte {
+	return map[string][]byte{"users.sql": mysqlQueries}
+}
+
+func (m MysqlTemplate) SQLCCode() map[string][]byte {
+	return sqlcCode(mysqlQueriesCode)
+}
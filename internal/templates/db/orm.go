@@ -0,0 +1,39 @@
+package db
+
+import (
+	_ "embed"
+)
+
+type GormTemplate struct{}
+
+type EntTemplate struct{}
+
+//go:embed static/orm/gorm.go.tmpl
+var gormRepositoryTemplate []byte
+
+//go:embed static/orm/ent.go.tmpl
+var entRepositoryTemplate []byte
+
+//go:embed static/orm/ent/schema.go.tmpl
+var entSchemaTemplate []byte
+
+//go:embed static/orm/ent/generate.go.tmpl
+var entGenerateTemplate []byte
+
+func (m GormTemplate) Repository() []byte {
+	return gormRepositoryTemplate
+}
+
+func (m EntTemplate) Repository() []byte {
+	return entRepositoryTemplate
+}
+
+// Schema returns the ent schema of the sample entity.
+func (m EntTemplate) Schema() []byte {
+	return entSchemaTemplate
+}
+
+// Generate returns the file holding the go:generate directive that runs the ent code generator.
+func (m EntTemplate) Generate() []byte {
+	return entGenerateTemplate
+}
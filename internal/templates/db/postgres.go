@@ -15,6 +15,21 @@ var postgresEnvExampleTemplate []byte
 //go:embed static/env/postgres.tmpl
 var postgresEnvTemplate []byte
 
+//go:embed static/migrations/postgres/0001_create_users.up.sql
+var postgresInitialUpMigration []byte
+
+//go:embed static/migrations/postgres/0001_create_users.down.sql
+var postgresInitialDownMigration []byte
+
+//go:embed static/sqlc/postgres/sqlc.yaml
+var postgresSQLCConfig []byte
+
+//go:embed static/sqlc/postgres/users.sql
+var postgresQueries []byte
+
+//go:embed static/sqlc/postgres/users.sql.go.tmpl
+var postgresQueriesCode []byte
+
 func (m PostgresTemplate) Service() []byte {
 	return postgresServiceTemplate
 }
@@ -26,3 +41,30 @@ func (m PostgresTemplate) Env() []byte {
 func (m PostgresTemplate) EnvExample() []byte {
 	return postgresEnvExampleTemplate
 }
+
+func (m PostgresTemplate) Migrate() []byte {
+	return sqlMigrateTemplate
+}
+
+func (m PostgresTemplate) MigrateCmd() []byte {
+	return sqlMigrateCmdTemplate
+}
+
+func (m PostgresTemplate) Migrations() map[string][]byte {
+	return map[string][]byte{
+		initialUpMigration:   postgresInitialUpMigration,
+		initialDownMigration: postgresInitialDownMigration,
+	}
+}
+
+func (m PostgresTemplate) SQLCConfig() []byte {
+	return postgresSQLCConfig
+}
+
+func (m PostgresTemplate) Queries() map[string][]byte {
+	return map[string][]byte{"users.sql": postgresQueries}
+}
+
+func (m PostgresTemplate) SQLCCode() map[string][]byte {
+	return sqlcCode(postgresQueriesCode)
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	_ "embed"
+)
+
+type RedisTemplate struct{}
+
+//go:embed static/service/redis.go.tmpl
+var redisServiceTemplate []byte
+
+//go:embed static/env/example/redis.tmpl
+var redisEnvExampleTemplate []byte
+
+//go:embed static/env/redis.tmpl
+var redisEnvTemplate []byte
+
+func (m RedisTemplate) Service() []byte {
+	return redisServiceTemplate
+}
+
+func (m RedisTemplate) Env() []byte {
+	return redisEnvTemplate
+}
+
+func (m RedisTemplate) EnvExample() []byte {
+	return redisEnvExampleTemplate
+}
@@ -0,0 +1,20 @@
+package db
+
+import (
+	_ "embed"
+)
+
+//go:embed static/sqlc/db.go.tmpl
+var sqlcDBCode []byte
+
+//go:embed static/sqlc/models.go.tmpl
+var sqlcModelsCode []byte
+
+// sqlcCode returns the code generated by sqlc for the sample queries of a driver, keyed by file name.
+func sqlcCode(queriesCode []byte) map[string][]byte {
+	return map[string][]byte{
+		"db.go":        sqlcDBCode,
+		"models.go":    sqlcModelsCode,
+		"users.sql.go": queriesCode,
+	}
+}
@@ -15,6 +15,21 @@ var sqliteEnvExampleTemplate []byte
 //go:embed static/env/sqlite.tmpl
 var sqliteEnvTemplate []byte
 
+//go:embed static/migrations/sqlite/0001_create_users.up.sql
+var sqliteInitialUpMigration []byte
+
+//go:embed static/migrations/sqlite/0001_create_users.down.sql
+var sqliteInitialDownMigration []byte
+
+//go:embed static/sqlc/sqlite/sqlc.yaml
+var sqliteSQLCConfig []byte
+
+//go:embed static/sqlc/sqlite/users.sql
+var sqliteQueries []byte
+
+//go:embed static/sqlc/sqlite/users.sql.go.tmpl
+var sqliteQueriesCode []byte
+
 func (m SqliteTemplate) Service() []byte {
 	return sqliteServiceTemplate
 }
@@ -26,3 +41,30 @@ func (m SqliteTemplate) Env() []byte {
 func (m SqliteTemplate) EnvExample() []byte {
 	return sqliteEnvExampleTemplate
 }
+
+func (m SqliteTemplate) Migrate() []byte {
+	return sqlMigrateTemplate
+}
+
+func (m SqliteTemplate) MigrateCmd() []byte {
+	return sqlMigrateCmdTemplate
+}
+
+func (m SqliteTemplate) Migrations() map[string][]byte {
+	return map[string][]byte{
+		initialUpMigration:   sqliteInitialUpMigration,
+		initialDownMigration: sqliteInitialDownMigration,
+	}
+}
+
+func (m SqliteTemplate) SQLCConfig() []byte {
+	return sqliteSQLCConfig
+}
+
+func (m SqliteTemplate) Queries() map[string][]byte {
+	return map[string][]byte{"users.sql": sqliteQueries}
+}
+
+func (m SqliteTemplate) SQLCCode() map[string][]byte {
+	return sqlcCode(sqliteQueriesCode)
+}
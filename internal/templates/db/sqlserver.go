@@ -0,0 +1,28 @@
+package db
+
+import (
+	_ "embed"
+)
+
+type SqlServerTemplate struct{}
+
+//go:embed static/service/sqlserver.go.tmpl
+var sqlserverServiceTemplate []byte
+
+//go:embed static/env/example/sqlserver.tmpl
+var sqlserverEnvExampleTemplate []byte
+
+//go:embed static/env/sqlserver.tmpl
+var sqlserverEnvTemplate []byte
+
+func (m SqlServerTemplate) Service() []byte {
+	return sqlserverServiceTemplate
+}
+
+func (m SqlServerTemplate) Env() []byte {
+	return sqlserverEnvTemplate
+}
+
+func (m SqlServerTemplate) EnvExample() []byte {
+	return sqlserverEnvExampleTemplate
+}
@@ -0,0 +1,19 @@
+-- name: GetUser :one
+SELECT id, name, email, created_at FROM users
+WHERE id = ?;
+
+-- name: ListUsers :many
+SELECT id, name, email, created_at FROM users
+ORDER BY id;
+
+-- name: CreateUser :execlastid
+INSERT INTO users (name, email)
+VALUES (?, ?);
+
+-- name: UpdateUser :exec
+UPDATE users SET name = ?, email = ?
+WHERE id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
@@ -0,0 +1,21 @@
+-- name: GetUser :one
+SELECT id, name, email, created_at FROM users
+WHERE id = ?;
+
+-- name: ListUsers :many
+SELECT id, name, email, created_at FROM users
+ORDER BY id;
+
+-- name: CreateUser :one
+INSERT INTO users (name, email)
+VALUES (?, ?)
+RETURNING id, name, email, created_at;
+
+-- name: UpdateUser :one
+UPDATE users SET name = ?, email = ?
+WHERE id = ?
+RETURNING id, name, email, created_at;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
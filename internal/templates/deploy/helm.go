@@ -0,0 +1,70 @@
+package deploy
+
+import (
+	_ "embed"
+)
+
+//go:embed static/helm/Chart.yaml.tmpl
+var helmChartTemplate []byte
+
+//go:embed static/helm/values.yaml.tmpl
+var helmValuesTemplate []byte
+
+//go:embed static/helm/helmignore.tmpl
+var helmIgnoreTemplate []byte
+
+//go:embed static/helm/templates/_helpers.tpl.tmpl
+var helmHelpersTemplate []byte
+
+//go:embed static/helm/templates/NOTES.txt.tmpl
+var helmNotesTemplate []byte
+
+//go:embed static/helm/templates/deployment.yaml.tmpl
+var helmDeploymentTemplate []byte
+
+//go:embed static/helm/templates/service.yaml.tmpl
+var helmServiceTemplate []byte
+
+//go:embed static/helm/templates/ingress.yaml.tmpl
+var helmIngressTemplate []byte
+
+//go:embed static/helm/templates/hpa.yaml.tmpl
+var helmHorizontalPodAutoscalerTemplate []byte
+
+//go:embed static/helm/templates/configmap.yaml.tmpl
+var helmConfigMapTemplate []byte
+
+//go:embed static/helm/templates/secret.yaml.tmpl
+var helmSecretTemplate []byte
+
+//go:embed static/helm/templates/pvc.yaml.tmpl
+var helmPersistentVolumeClaimTemplate []byte
+
+//go:embed static/helm/templates/database.yaml.tmpl
+var helmDatabaseTemplate []byte
+
+//go:embed static/helm/templates/cache.yaml.tmpl
+var helmCacheTemplate []byte
+
+// HelmChartTemplates returns the templates of the Helm chart, by path in the chart. They are executed with the
+// [[ ]] delimiters, leaving the {{ }} actions to Helm. The HorizontalPodAutoscaler is not used by the SQLite
+// projects, the PersistentVolumeClaim only by them, the database and the cache only by the projects running
+// them.
+func HelmChartTemplates() map[string][]byte {
+	return map[string][]byte{
+		"Chart.yaml":                helmChartTemplate,
+		"values.yaml":               helmValuesTemplate,
+		".helmignore":               helmIgnoreTemplate,
+		"templates/_helpers.tpl":    helmHelpersTemplate,
+		"templates/NOTES.txt":       helmNotesTemplate,
+		"templates/deployment.yaml": helmDeploymentTemplate,
+		"templates/service.yaml":    helmServiceTemplate,
+		"templates/ingress.yaml":    helmIngressTemplate,
+		"templates/hpa.yaml":        helmHorizontalPodAutoscalerTemplate,
+		"templates/configmap.yaml":  helmConfigMapTemplate,
+		"templates/secret.yaml":     helmSecretTemplate,
+		"templates/pvc.yaml":        helmPersistentVolumeClaimTemplate,
+		"templates/database.yaml":   helmDatabaseTemplate,
+		"templates/cache.yaml":      helmCacheTemplate,
+	}
+}
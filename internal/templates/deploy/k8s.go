@@ -0,0 +1,55 @@
+// Package deploy provides the templates of the deployment targets of the generated projects.
+package deploy
+
+import (
+	_ "embed"
+)
+
+//go:embed static/k8s/base/kustomization.yaml.tmpl
+var k8sKustomizationTemplate []byte
+
+//go:embed static/k8s/base/deployment.yaml.tmpl
+var k8sDeploymentTemplate []byte
+
+//go:embed static/k8s/base/service.yaml.tmpl
+var k8sServiceTemplate []byte
+
+//go:embed static/k8s/base/configmap.yaml.tmpl
+var k8sConfigMapTemplate []byte
+
+//go:embed static/k8s/base/secret.yaml.tmpl
+var k8sSecretTemplate []byte
+
+//go:embed static/k8s/base/pvc.yaml.tmpl
+var k8sPersistentVolumeClaimTemplate []byte
+
+//go:embed static/k8s/overlay/kustomization.yaml.tmpl
+var k8sOverlayKustomizationTemplate []byte
+
+//go:embed static/k8s/overlay/deployment-patch.yaml.tmpl
+var k8sOverlayDeploymentPatchTemplate []byte
+
+//go:embed static/k8s/overlay/configmap-patch.yaml.tmpl
+var k8sOverlayConfigMapPatchTemplate []byte
+
+// K8sBaseTemplates returns the templates of the kustomize base of the Kubernetes manifests, by file name. The
+// PersistentVolumeClaim is only used by the SQLite projects.
+func K8sBaseTemplates() map[string][]byte {
+	return map[string][]byte{
+		"kustomization.yaml": k8sKustomizationTemplate,
+		"deployment.yaml":    k8sDeploymentTemplate,
+		"service.yaml":       k8sServiceTemplate,
+		"configmap.yaml":     k8sConfigMapTemplate,
+		"secret.yaml":        k8sSecretTemplate,
+		"pvc.yaml":           k8sPersistentVolumeClaimTemplate,
+	}
+}
+
+// K8sOverlayTemplates returns the templates of a kustomize overlay of the Kubernetes manifests, by file name.
+func K8sOverlayTemplates() map[string][]byte {
+	return map[string][]byte{
+		"kustomization.yaml":    k8sOverlayKustomizationTemplate,
+		"deployment-patch.yaml": k8sOverlayDeploymentPatchTemplate,
+		"configmap-patch.yaml":  k8sOverlayConfigMapPatchTemplate,
+	}
+}
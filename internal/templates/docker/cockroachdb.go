@@ -0,0 +1,14 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+type CockroachDBDockerTemplate struct{}
+
+//go:embed static/docker-compose/cockroachdb.tmpl
+var cockroachdbDockerTemplate []byte
+
+func (m CockroachDBDockerTemplate) Docker() []byte {
+	return cockroachdbDockerTemplate
+}
@@ -0,0 +1,22 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+//go:embed static/docker-compose.tmpl
+var composeTemplate []byte
+
+//go:embed static/docker-compose.override.tmpl
+var composeOverrideTemplate []byte
+
+// ComposeTemplate returns the template of the docker-compose file running the application with its services. It
+// executes the "database" template of the database driver, defined by the Docker template of the driver.
+func ComposeTemplate() []byte {
+	return composeTemplate
+}
+
+// ComposeOverrideTemplate returns the template of the docker-compose override running the application with air.
+func ComposeOverrideTemplate() []byte {
+	return composeOverrideTemplate
+}
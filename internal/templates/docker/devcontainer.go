@@ -0,0 +1,22 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+//go:embed static/devcontainer/devcontainer.json.tmpl
+var devContainerTemplate []byte
+
+//go:embed static/devcontainer/docker-compose.tmpl
+var devContainerComposeTemplate []byte
+
+// DevContainerTemplate returns the template of the devcontainer.json file opening the project in its dev container.
+func DevContainerTemplate() []byte {
+	return devContainerTemplate
+}
+
+// DevContainerComposeTemplate returns the template of the docker-compose file of the dev container, merged into
+// the docker-compose file of the project to run it next to the services of the application.
+func DevContainerComposeTemplate() []byte {
+	return devContainerComposeTemplate
+}
@@ -0,0 +1,21 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+//go:embed static/Dockerfile.tmpl
+var dockerfileTemplate []byte
+
+//go:embed static/dockerignore.tmpl
+var dockerignoreTemplate []byte
+
+// DockerfileTemplate returns the template of the multi-stage Dockerfile building the image of the application.
+func DockerfileTemplate() []byte {
+	return dockerfileTemplate
+}
+
+// DockerignoreTemplate returns the template of the .dockerignore file excluding the local files from the build context.
+func DockerignoreTemplate() []byte {
+	return dockerignoreTemplate
+}
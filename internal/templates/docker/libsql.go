@@ -0,0 +1,14 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+type LibSQLDockerTemplate struct{}
+
+//go:embed static/docker-compose/libsql.tmpl
+var libsqlDockerTemplate []byte
+
+func (m LibSQLDockerTemplate) Docker() []byte {
+	return libsqlDockerTemplate
+}
@@ -0,0 +1,14 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+type RedisDockerTemplate struct{}
+
+//go:embed static/docker-compose/redis.tmpl
+var redisDockerTemplate []byte
+
+func (m RedisDockerTemplate) Docker() []byte {
+	return redisDockerTemplate
+}
@@ -0,0 +1,14 @@
+package docker
+
+import (
+	_ "embed"
+)
+
+type SqlServerDockerTemplate struct{}
+
+//go:embed static/docker-compose/sqlserver.tmpl
+var sqlserverDockerTemplate []byte
+
+func (m SqlServerDockerTemplate) Docker() []byte {
+	return sqlserverDockerTemplate
+}
@@ -10,3 +10,11 @@ var envTemplate []byte
 func EnvTemplate() []byte {
 	return envTemplate
 }
+
+//go:embed static/env_profile.tmpl
+var envProfileTemplate []byte
+
+// EnvProfileTemplate returns the template of the .env.<profile> overlay of an environment profile.
+func EnvProfileTemplate() []byte {
+	return envProfileTemplate
+}
@@ -0,0 +1,40 @@
+// Package handler provides the templates of the HTTP handlers generated in an existing project.
+package handler
+
+import (
+	_ "embed"
+)
+
+//go:embed static/nethttp.go.tmpl
+var netHTTPHandlerTemplate []byte
+
+//go:embed static/gin.go.tmpl
+var ginHandlerTemplate []byte
+
+//go:embed static/echo.go.tmpl
+var echoHandlerTemplate []byte
+
+//go:embed static/fiber.go.tmpl
+var fiberHandlerTemplate []byte
+
+//go:embed static/handler_test.go.tmpl
+var handlerTestTemplate []byte
+
+// Handler returns the template of a handler stub for the web framework.
+// The standard library, chi, gorilla/mux and httprouter share the net/http handler.
+func Handler(framework string) []byte {
+	switch framework {
+	case "gin":
+		return ginHandlerTemplate
+	case "echo":
+		return echoHandlerTemplate
+	case "fiber":
+		return fiberHandlerTemplate
+	}
+	return netHTTPHandlerTemplate
+}
+
+// HandlerTest returns the template of the test of a handler stub.
+func HandlerTest() []byte {
+	return handlerTestTemplate
+}
@@ -0,0 +1,7 @@
+// Package template provides a set of templates for the main function, HTTP server, README, and Makefile.
+package template
+
+import _ "embed"
+
+//go:embed static/health.go.tmpl
+var HealthTemplate []byte
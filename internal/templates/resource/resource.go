@@ -0,0 +1,90 @@
+// Package resource provides the templates of the CRUD resources generated in an existing project.
+package resource
+
+import (
+	_ "embed"
+)
+
+//go:embed static/repository/sql.go.tmpl
+var sqlRepositoryTemplate []byte
+
+//go:embed static/repository/mongo.go.tmpl
+var mongoRepositoryTemplate []byte
+
+//go:embed static/repository/gorm.go.tmpl
+var gormRepositoryTemplate []byte
+
+//go:embed static/repository/ent.go.tmpl
+var entRepositoryTemplate []byte
+
+//go:embed static/repository/errors.go.tmpl
+var errorsTemplate []byte
+
+//go:embed static/ent/schema.go.tmpl
+var entSchemaTemplate []byte
+
+//go:embed static/handlers/nethttp.go.tmpl
+var netHTTPHandlersTemplate []byte
+
+//go:embed static/handlers/gin.go.tmpl
+var ginHandlersTemplate []byte
+
+//go:embed static/handlers/echo.go.tmpl
+var echoHandlersTemplate []byte
+
+//go:embed static/handlers/fiber.go.tmpl
+var fiberHandlersTemplate []byte
+
+//go:embed static/handlers/handlers_test.go.tmpl
+var handlersTestTemplate []byte
+
+//go:embed static/httputil.go.tmpl
+var httpUtilTemplate []byte
+
+// Repository returns the template of the model and the repository of a resource for the database driver and data access style.
+func Repository(databaseDriver, dataAccess string) []byte {
+	switch {
+	case dataAccess == "gorm":
+		return gormRepositoryTemplate
+	case dataAccess == "ent":
+		return entRepositoryTemplate
+	case databaseDriver == "mongo":
+		return mongoRepositoryTemplate
+	}
+	return sqlRepositoryTemplate
+}
+
+// Errors returns the template of the ErrNotFound error returned by the repositories.
+func Errors() []byte {
+	return errorsTemplate
+}
+
+// EntSchema returns the template of the ent schema of a resource.
+func EntSchema() []byte {
+	return entSchemaTemplate
+}
+
+// Handlers returns the template of the handlers of a resource for the web framework.
+// The standard library, chi, gorilla/mux and httprouter share the net/http handlers.
+func Handlers(framework string) []byte {
+	switch framework {
+	case "gin":
+		return ginHandlersTemplate
+	case "echo":
+		return echoHandlersTemplate
+	case "fiber":
+		return fiberHandlersTemplate
+	}
+	return netHTTPHandlersTemplate
+}
+
+// HandlersTest returns the template of the test of the handlers of a resource.
+func HandlersTest() []byte {
+	return handlersTestTemplate
+}
+
+// HTTPUtil returns the template of the JSON helpers used by the net/http handlers, generated in the
+// projects whose framework does not come with them.
+func HTTPUtil() []byte {
+	return httpUtilTemplate
+}
@@ -0,0 +1,21 @@
+// Package web provides a set of templates for the specified web router.
+package web
+
+import _ "embed"
+
+//go:embed static/environment/nethttp.go.tmpl
+var environmentTemplate []byte
+
+//go:embed static/environment/nethttp_test.go.tmpl
+var environmentTestTemplate []byte
+
+// EnvironmentTemplate returns the template of the CORS policy and debug endpoints of the net/http based servers,
+// which depend on the environment of the application. Fiber uses its own middlewares instead.
+func EnvironmentTemplate() []byte {
+	return environmentTemplate
+}
+
+// EnvironmentTestTemplate returns the template of the test of EnvironmentTemplate.
+func EnvironmentTestTemplate() []byte {
+	return environmentTestTemplate
+}
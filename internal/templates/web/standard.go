@@ -16,6 +16,9 @@ var standardDatabaseRoutesTemplate []byte
 //go:embed static/db/server/standard.go.tmpl
 var standardDatabaseServerTemplate []byte
 
+//go:embed static/httputil/standard.go.tmpl
+var standardHTTPUtilTemplate []byte
+
 // StandardLibraryTemplate is a struct that provides methods to generate templates for a standard library-based HTTP server.
 type StandardLibraryTemplate struct{}
 
@@ -43,3 +46,8 @@ func (s StandardLibraryTemplate) ServerWithDB() []byte {
 func (s StandardLibraryTemplate) RoutesWithDB() []byte {
 	return standardDatabaseRoutesTemplate
 }
+
+// HTTPUtil returns the template of the middleware and JSON helpers for the standard-library-based HTTP server.
+func (s StandardLibraryTemplate) HTTPUtil() []byte {
+	return standardHTTPUtilTemplate
+}
@@ -4,4 +4,5 @@ rm -rf completions
 mkdir completions
 for sh in bash zsh fish; do
   go run main.go completion "$sh" >"completions/goforge.$sh"
-done
\ No newline at end of file
+done
+go run main.go completion powershell >"completions/goforge.ps1"